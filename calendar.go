@@ -0,0 +1,92 @@
+package main
+
+import (
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+)
+
+// Due dates are compared by calendar day in a single timezone, the one set by
+// `timezone = "America/Toronto"` in config.toml or the system's otherwise.
+// Reminders reports due dates in UTC, so they are converted before anything
+// looks at their day.
+
+// Timezone due dates are shown and compared in (set by loadConfig in reminders.go)
+var dueLocation = time.Local
+
+// configureTimezone sets dueLocation from an IANA timezone name
+func configureTimezone(name string) []configProblem {
+	dueLocation = time.Local
+	if strings.TrimSpace(name) == "" {
+		return nil
+	}
+	loc, err := time.LoadLocation(strings.TrimSpace(name))
+	if err != nil {
+		return []configProblem{newConfigProblem(toml.Key{"timezone"}, "unknown timezone %q", name)}
+	}
+	dueLocation = loc
+	return nil
+}
+
+// currentTime is the time now on appClock, in dueLocation
+func currentTime() time.Time {
+	return appClock.Now().In(dueLocation)
+}
+
+// parseDueDate reads a due or start date from Reminders. Dates without a
+// time of day (either a bare "2006-01-02" or a time at exactly midnight on
+// the system clock) are all-day reminders, due at some point during that day.
+//
+// Reminders writes all-day dates as the system's midnight, so that's checked
+// before converting to dueLocation, where it may not be midnight or even the
+// same day. The day is kept and becomes midnight in dueLocation. A reminder
+// timed at midnight in dueLocation stays timed, unless that's also midnight on
+// the system clock, which Reminders' output can't tell apart.
+func parseDueDate(s string) (t time.Time, allDay bool, ok bool) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		if local := t.In(time.Local); isMidnight(local) {
+			return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, dueLocation), true, true
+		}
+		return t.In(dueLocation), false, true
+	}
+	if t, err := time.ParseInLocation(time.DateOnly, s, dueLocation); err == nil {
+		return t, true, true
+	}
+	return time.Time{}, false, false
+}
+
+func isMidnight(t time.Time) bool {
+	return t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 && t.Nanosecond() == 0
+}
+
+// startOfDay returns midnight at the start of t's day in t's location
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// calendarDays counts calendar days from from's day to to's day in
+// dueLocation: 0 for the same day, 1 for the next, -1 for the day before.
+// Counting dates rather than hours keeps 23 and 25 hour days around DST
+// changes from shifting the result.
+func calendarDays(from, to time.Time) int {
+	from, to = from.In(dueLocation), to.In(dueLocation)
+	a := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	b := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(b.Sub(a).Hours() / 24)
+}
+
+// dueDeadline is when a reminder stops being on time: its due time, or the
+// end of its due day for all-day reminders
+func dueDeadline(due time.Time, allDay bool) time.Time {
+	if !allDay {
+		return due
+	}
+	due = due.In(dueLocation)
+	return time.Date(due.Year(), due.Month(), due.Day()+1, 0, 0, 0, 0, dueLocation)
+}
+
+// isOverdue reports whether a reminder due at due is overdue at now
+func isOverdue(due time.Time, allDay bool, now time.Time) bool {
+	return !now.Before(dueDeadline(due, allDay))
+}
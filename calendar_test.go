@@ -0,0 +1,181 @@
+package main
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata" // fixed zones whatever the machine has installed
+)
+
+// useZones runs a test with the system clock in local and due dates compared
+// in due, restoring both afterwards. English labels and Monday weeks too.
+func useZones(t *testing.T, local, due string) {
+	t.Helper()
+	load := func(name string) *time.Location {
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			t.Fatalf("load %s: %v", name, err)
+		}
+		return loc
+	}
+	oldLocal, oldDue, oldDates := time.Local, dueLocation, dates
+	t.Cleanup(func() { time.Local, dueLocation, dates = oldLocal, oldDue, oldDates })
+	time.Local, dueLocation = load(local), load(due)
+	dates = newDateFormatter(locales["en"], formatLayouts{}, false, time.Monday)
+}
+
+// at is a time in dueLocation
+func at(year int, month time.Month, day, hour, min int) time.Time {
+	return time.Date(year, month, day, hour, min, 0, 0, dueLocation)
+}
+
+func TestCalendarDays(t *testing.T) {
+	useZones(t, "America/Toronto", "America/Toronto")
+
+	tests := []struct {
+		name     string
+		from, to time.Time
+		want     int
+	}{
+		{"same day", at(2026, 10, 18, 0, 0), at(2026, 10, 18, 23, 59), 0},
+		{"next day by minutes", at(2026, 10, 18, 23, 59), at(2026, 10, 19, 0, 1), 1},
+		{"day before", at(2026, 10, 18, 0, 1), at(2026, 10, 17, 23, 59), -1},
+		{"month end", at(2026, 1, 31, 23, 0), at(2026, 2, 1, 1, 0), 1},
+		{"30 day month end", at(2026, 4, 30, 12, 0), at(2026, 5, 1, 12, 0), 1},
+		{"year end", at(2026, 12, 31, 22, 0), at(2027, 1, 1, 8, 0), 1},
+		{"february in a common year", at(2026, 2, 28, 12, 0), at(2026, 3, 1, 12, 0), 1},
+		{"february in a leap year", at(2028, 2, 28, 12, 0), at(2028, 3, 1, 12, 0), 2},
+		{"leap day to march", at(2028, 2, 29, 23, 0), at(2028, 3, 1, 0, 0), 1},
+		{"across a leap year", at(2028, 1, 1, 0, 0), at(2029, 1, 1, 0, 0), 366},
+		{"spring forward, 23 hour day", at(2026, 3, 8, 0, 0), at(2026, 3, 9, 0, 0), 1},
+		{"across spring forward", at(2026, 3, 7, 12, 0), at(2026, 3, 8, 12, 0), 1},
+		{"fall back, 25 hour day", at(2026, 11, 1, 0, 0), at(2026, 11, 2, 0, 0), 1},
+		{"within fall back day", at(2026, 11, 1, 0, 30), at(2026, 11, 1, 23, 30), 0},
+		{"week across fall back", at(2026, 10, 28, 9, 0), at(2026, 11, 4, 9, 0), 7},
+		{"from UTC, previous day locally", time.Date(2026, 10, 18, 3, 0, 0, 0, time.UTC), at(2026, 10, 18, 10, 0), 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := calendarDays(tt.from, tt.to); got != tt.want {
+				t.Errorf("calendarDays(%v, %v) = %d, want %d", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDueDate(t *testing.T) {
+	tests := []struct {
+		name       string
+		local, due string // system and configured timezones
+		in         string
+		want       time.Time // in the due timezone, zero when not ok
+		wantAllDay bool
+	}{
+		{"bare date", "America/Toronto", "America/Toronto", "2026-10-18", time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC), true},
+		{"bare leap day", "America/Toronto", "America/Toronto", "2028-02-29", time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC), true},
+		{"local midnight in UTC", "America/Toronto", "America/Toronto", "2026-10-18T04:00:00Z", time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC), true},
+		{"timed", "America/Toronto", "America/Toronto", "2026-10-18T13:30:00Z", time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC), false},
+		{"midnight on spring forward day", "America/Toronto", "America/Toronto", "2026-03-08T05:00:00Z", time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC), true},
+		{"midnight after spring forward", "America/Toronto", "America/Toronto", "2026-03-09T04:00:00Z", time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC), true},
+		{"midnight on fall back day", "America/Toronto", "America/Toronto", "2026-11-01T04:00:00Z", time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC), true},
+		{"midnight after fall back", "America/Toronto", "America/Toronto", "2026-11-02T05:00:00Z", time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC), true},
+		{"all-day keeps its day in another timezone", "America/Toronto", "Europe/Berlin", "2026-10-18T04:00:00Z", time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC), true},
+		{"all-day keeps its day west of the system", "Europe/Berlin", "America/Toronto", "2026-10-17T22:00:00Z", time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC), true},
+		{"timed at midnight in the configured timezone", "America/Toronto", "Europe/Berlin", "2026-10-17T22:00:00Z", time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC), false},
+		{"timed with an offset", "America/Toronto", "Europe/Berlin", "2026-10-18T09:30:00-04:00", time.Date(2026, 10, 18, 15, 30, 0, 0, time.UTC), false},
+		{"no leap day in 2026", "America/Toronto", "America/Toronto", "2026-02-29", time.Time{}, false},
+		{"not a date", "America/Toronto", "America/Toronto", "tomorrow", time.Time{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useZones(t, tt.local, tt.due)
+			got, allDay, ok := parseDueDate(tt.in)
+			if tt.want.IsZero() {
+				if ok {
+					t.Fatalf("parseDueDate(%q) = %v, want not ok", tt.in, got)
+				}
+				return
+			}
+			if !ok {
+				t.Fatalf("parseDueDate(%q) not ok", tt.in)
+			}
+			// want is written as a wall clock in the due timezone
+			want := time.Date(tt.want.Year(), tt.want.Month(), tt.want.Day(), tt.want.Hour(), tt.want.Minute(), 0, 0, dueLocation)
+			if !got.Equal(want) || allDay != tt.wantAllDay {
+				t.Errorf("parseDueDate(%q) = %v, allDay %v; want %v, allDay %v", tt.in, got, allDay, want, tt.wantAllDay)
+			}
+			if got.Location() != dueLocation {
+				t.Errorf("parseDueDate(%q) in %v, want %v", tt.in, got.Location(), dueLocation)
+			}
+		})
+	}
+}
+
+func TestDueDeadlineAndIsOverdue(t *testing.T) {
+	useZones(t, "America/Toronto", "America/Toronto")
+
+	tests := []struct {
+		name         string
+		due          time.Time
+		allDay       bool
+		wantDeadline time.Time
+	}{
+		{"timed", at(2026, 10, 18, 9, 30), false, at(2026, 10, 18, 9, 30)},
+		{"all-day", at(2026, 10, 18, 0, 0), true, at(2026, 10, 19, 0, 0)},
+		{"all-day at month end", at(2026, 10, 31, 0, 0), true, at(2026, 11, 1, 0, 0)},
+		{"all-day at year end", at(2026, 12, 31, 0, 0), true, at(2027, 1, 1, 0, 0)},
+		{"all-day before leap day", at(2028, 2, 28, 0, 0), true, at(2028, 2, 29, 0, 0)},
+		{"all-day on leap day", at(2028, 2, 29, 0, 0), true, at(2028, 3, 1, 0, 0)},
+		{"all-day on spring forward day", at(2026, 3, 8, 0, 0), true, at(2026, 3, 9, 0, 0)},
+		{"all-day on fall back day", at(2026, 11, 1, 0, 0), true, at(2026, 11, 2, 0, 0)},
+		{"all-day given in UTC", time.Date(2026, 10, 18, 4, 0, 0, 0, time.UTC), true, at(2026, 10, 19, 0, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deadline := dueDeadline(tt.due, tt.allDay)
+			if !deadline.Equal(tt.wantDeadline) {
+				t.Fatalf("dueDeadline(%v, %v) = %v, want %v", tt.due, tt.allDay, deadline, tt.wantDeadline)
+			}
+			if isOverdue(tt.due, tt.allDay, deadline.Add(-time.Minute)) {
+				t.Errorf("overdue a minute before the deadline %v", deadline)
+			}
+			if !isOverdue(tt.due, tt.allDay, deadline) {
+				t.Errorf("not overdue at the deadline %v", deadline)
+			}
+		})
+	}
+}
+
+func TestWithTimesDayLabels(t *testing.T) {
+	useZones(t, "America/Toronto", "America/Toronto")
+
+	wednesday := at(2026, 10, 14, 10, 0)
+	tests := []struct {
+		name   string
+		now    time.Time
+		due    time.Time
+		allDay bool
+		want   string
+	}{
+		{"all-day today", wednesday, at(2026, 10, 14, 0, 0), true, "Today • Work"},
+		{"timed today", wednesday, at(2026, 10, 14, 15, 0), false, "Today 15:00 • Work"},
+		{"earlier today", wednesday, at(2026, 10, 14, 8, 0), false, "Today 08:00 • Work"},
+		{"tomorrow", wednesday, at(2026, 10, 15, 0, 0), true, "Tomorrow • Work"},
+		{"later this week", wednesday, at(2026, 10, 16, 0, 0), true, "Friday • Work"},
+		{"end of this week", wednesday, at(2026, 10, 18, 9, 0), false, "Sunday 09:00 • Work"},
+		{"next week", wednesday, at(2026, 10, 19, 0, 0), true, "Oct 19 • Work"},
+		{"yesterday", wednesday, at(2026, 10, 13, 0, 0), true, "Oct 13 • Work"},
+		{"tomorrow across month end", at(2026, 10, 31, 20, 0), at(2026, 11, 1, 0, 0), true, "Tomorrow • Work"},
+		{"tomorrow across year end", at(2026, 12, 31, 23, 30), at(2027, 1, 1, 0, 30), false, "Tomorrow 00:30 • Work"},
+		{"tomorrow on leap day", at(2028, 2, 28, 12, 0), at(2028, 2, 29, 0, 0), true, "Tomorrow • Work"},
+		{"tomorrow across spring forward", at(2026, 3, 7, 23, 30), at(2026, 3, 8, 9, 0), false, "Tomorrow 09:00 • Work"},
+		{"today on fall back day", at(2026, 11, 1, 1, 30), at(2026, 11, 1, 23, 0), false, "Today 23:00 • Work"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			it := item{listName: "Work", parsedDate: tt.due, allDay: tt.allDay}.withTimes(tt.now)
+			if it.description != tt.want {
+				t.Errorf("description = %q, want %q", it.description, tt.want)
+			}
+		})
+	}
+}
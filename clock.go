@@ -0,0 +1,50 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// clock tells the app what time it is. Everything shown to the user reads
+// the time through appClock, so freezing it with --now (or
+// REMINDERS_DASHBOARD_NOW) makes screenshots, recordings and golden output
+// come out the same on every run.
+type clock interface {
+	Now() time.Time
+}
+
+// systemClock is the real time
+type systemClock struct{}
+
+func (systemClock) Now() time.Time { return time.Now() }
+
+// frozenClock always returns the same instant
+type frozenClock time.Time
+
+func (c frozenClock) Now() time.Time { return time.Time(c) }
+
+// Clock the app reads the time from (replaced by --now in main.go)
+var appClock clock = systemClock{}
+
+// Layouts accepted by --now, tried in order. Times without a zone are local.
+var nowLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	time.DateOnly,
+}
+
+// parseNow reads a --now value like "2026-03-09T09:30" or
+// "2026-03-09T09:30:00-05:00"
+func parseNow(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	for _, layout := range nowLayouts {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q, expected e.g. 2026-03-09T09:30 or RFC 3339", value)
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseNow(t *testing.T) {
+	useZones(t, "America/Toronto", "America/Toronto")
+	toronto := time.Local
+
+	tests := []struct {
+		in   string
+		want time.Time // zero when invalid
+	}{
+		{"2026-03-09T09:30:00-05:00", time.Date(2026, 3, 9, 14, 30, 0, 0, time.UTC)},
+		{"2026-03-09T09:30:00Z", time.Date(2026, 3, 9, 9, 30, 0, 0, time.UTC)},
+		{"2026-03-09T09:30:15", time.Date(2026, 3, 9, 9, 30, 15, 0, toronto)},
+		{"2026-03-09T09:30", time.Date(2026, 3, 9, 9, 30, 0, 0, toronto)},
+		{"2026-03-09 09:30:15", time.Date(2026, 3, 9, 9, 30, 15, 0, toronto)},
+		{"2026-03-09 09:30", time.Date(2026, 3, 9, 9, 30, 0, 0, toronto)},
+		{"2026-03-09", time.Date(2026, 3, 9, 0, 0, 0, 0, toronto)},
+		{"  2026-03-09T09:30\n", time.Date(2026, 3, 9, 9, 30, 0, 0, toronto)},
+		{"2028-02-29", time.Date(2028, 2, 29, 0, 0, 0, 0, toronto)},
+		{"2026-02-29", time.Time{}},
+		{"2026-03-09T25:00", time.Time{}},
+		{"09:30", time.Time{}},
+		{"tomorrow", time.Time{}},
+		{"", time.Time{}},
+	}
+	for _, tt := range tests {
+		got, err := parseNow(tt.in)
+		if tt.want.IsZero() {
+			if err == nil {
+				t.Errorf("parseNow(%q) = %v, want an error", tt.in, got)
+			}
+			continue
+		}
+		if err != nil || !got.Equal(tt.want) {
+			t.Errorf("parseNow(%q) = %v, %v; want %v", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestFrozenClock(t *testing.T) {
+	useZones(t, "America/Toronto", "Europe/Berlin")
+	old := appClock
+	t.Cleanup(func() { appClock = old })
+
+	frozen := time.Date(2026, 3, 9, 14, 30, 0, 0, time.UTC)
+	appClock = frozenClock(frozen)
+	got := currentTime()
+	if !got.Equal(frozen) || got.Location() != dueLocation {
+		t.Errorf("currentTime() = %v, want %v in %v", got, frozen, dueLocation)
+	}
+}
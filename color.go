@@ -0,0 +1,253 @@
+package main
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/charmbracelet/lipgloss"
+	tint "github.com/lrstanley/bubbletint"
+)
+
+// Colors in config.toml (list colors and the custom palette) can be written as:
+//
+//	"#ff8800" or "#f80"   hex
+//	"208"                 ANSI 256-color number (0-255)
+//	"brightCyan"          a slot of the current theme, so it follows theme changes
+//	"rebeccapurple"       a CSS color name
+//
+// Theme slots win over CSS names, so "red" is the theme's red.
+
+var hexColorPattern = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// normalizeColorName lowercases a name and drops separators, so "Bright Cyan",
+// "bright-cyan" and "brightCyan" are the same
+func normalizeColorName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	return strings.NewReplacer(" ", "", "-", "", "_", "").Replace(name)
+}
+
+// themeSlots maps normalized slot names to the theme colors they select
+var themeSlots = map[string]func(tint.Tint) lipgloss.TerminalColor{
+	"fg":            tint.Tint.Fg,
+	"foreground":    tint.Tint.Fg,
+	"bg":            tint.Tint.Bg,
+	"background":    tint.Tint.Bg,
+	"selectionbg":   tint.Tint.SelectionBg,
+	"cursor":        tint.Tint.Cursor,
+	"black":         tint.Tint.Black,
+	"red":           tint.Tint.Red,
+	"green":         tint.Tint.Green,
+	"yellow":        tint.Tint.Yellow,
+	"blue":          tint.Tint.Blue,
+	"purple":        tint.Tint.Purple,
+	"magenta":       tint.Tint.Purple,
+	"cyan":          tint.Tint.Cyan,
+	"white":         tint.Tint.White,
+	"brightblack":   tint.Tint.BrightBlack,
+	"brightred":     tint.Tint.BrightRed,
+	"brightgreen":   tint.Tint.BrightGreen,
+	"brightyellow":  tint.Tint.BrightYellow,
+	"brightblue":    tint.Tint.BrightBlue,
+	"brightpurple":  tint.Tint.BrightPurple,
+	"brightmagenta": tint.Tint.BrightPurple,
+	"brightcyan":    tint.Tint.BrightCyan,
+	"brightwhite":   tint.Tint.BrightWhite,
+}
+
+// resolveColor turns a color from config into a terminal color using the
+// current theme. ok is false for empty or unrecognized values.
+func resolveColor(value string) (lipgloss.TerminalColor, bool) {
+	return resolveColorIn(theme, value)
+}
+
+// resolveColorIn is resolveColor with theme slots taken from t
+func resolveColorIn(t tint.Tint, value string) (lipgloss.TerminalColor, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, false
+	}
+
+	if hexColorPattern.MatchString(value) {
+		return lipgloss.Color(value), true
+	}
+
+	if n, err := strconv.Atoi(value); err == nil {
+		if n < 0 || n > 255 {
+			return nil, false
+		}
+		return lipgloss.Color(value), true
+	}
+
+	name := normalizeColorName(value)
+	if slot, ok := themeSlots[name]; ok {
+		return slot(t), true
+	}
+	if hex, ok := cssColors[name]; ok {
+		return lipgloss.Color(hex), true
+	}
+	return nil, false
+}
+
+// colorOr resolves value, falling back when it is empty or unrecognized
+func colorOr(value string, fallback lipgloss.TerminalColor) lipgloss.TerminalColor {
+	if c, ok := resolveColor(value); ok {
+		return c
+	}
+	return fallback
+}
+
+// colorProblems reports the list colors in config that can't be resolved
+func colorProblems(listColors map[string]string) []configProblem {
+	var problems []configProblem
+	for name, value := range listColors {
+		if _, ok := resolveColor(value); !ok {
+			problems = append(problems, newConfigProblem(toml.Key{"listColors", name}, "unknown color %q for list %q", value, name))
+		}
+	}
+	return problems
+}
+
+// cssColors are the CSS named colors
+var cssColors = map[string]string{
+	"aliceblue":            "#f0f8ff",
+	"antiquewhite":         "#faebd7",
+	"aqua":                 "#00ffff",
+	"aquamarine":           "#7fffd4",
+	"azure":                "#f0ffff",
+	"beige":                "#f5f5dc",
+	"bisque":               "#ffe4c4",
+	"blanchedalmond":       "#ffebcd",
+	"blueviolet":           "#8a2be2",
+	"brown":                "#a52a2a",
+	"burlywood":            "#deb887",
+	"cadetblue":            "#5f9ea0",
+	"chartreuse":           "#7fff00",
+	"chocolate":            "#d2691e",
+	"coral":                "#ff7f50",
+	"cornflowerblue":       "#6495ed",
+	"cornsilk":             "#fff8dc",
+	"crimson":              "#dc143c",
+	"darkblue":             "#00008b",
+	"darkcyan":             "#008b8b",
+	"darkgoldenrod":        "#b8860b",
+	"darkgray":             "#a9a9a9",
+	"darkgreen":            "#006400",
+	"darkgrey":             "#a9a9a9",
+	"darkkhaki":            "#bdb76b",
+	"darkmagenta":          "#8b008b",
+	"darkolivegreen":       "#556b2f",
+	"darkorange":           "#ff8c00",
+	"darkorchid":           "#9932cc",
+	"darkred":              "#8b0000",
+	"darksalmon":           "#e9967a",
+	"darkseagreen":         "#8fbc8f",
+	"darkslateblue":        "#483d8b",
+	"darkslategray":        "#2f4f4f",
+	"darkslategrey":        "#2f4f4f",
+	"darkturquoise":        "#00ced1",
+	"darkviolet":           "#9400d3",
+	"deeppink":             "#ff1493",
+	"deepskyblue":          "#00bfff",
+	"dimgray":              "#696969",
+	"dimgrey":              "#696969",
+	"dodgerblue":           "#1e90ff",
+	"firebrick":            "#b22222",
+	"floralwhite":          "#fffaf0",
+	"forestgreen":          "#228b22",
+	"fuchsia":              "#ff00ff",
+	"gainsboro":            "#dcdcdc",
+	"ghostwhite":           "#f8f8ff",
+	"gold":                 "#ffd700",
+	"goldenrod":            "#daa520",
+	"gray":                 "#808080",
+	"greenyellow":          "#adff2f",
+	"grey":                 "#808080",
+	"honeydew":             "#f0fff0",
+	"hotpink":              "#ff69b4",
+	"indianred":            "#cd5c5c",
+	"indigo":               "#4b0082",
+	"ivory":                "#fffff0",
+	"khaki":                "#f0e68c",
+	"lavender":             "#e6e6fa",
+	"lavenderblush":        "#fff0f5",
+	"lawngreen":            "#7cfc00",
+	"lemonchiffon":         "#fffacd",
+	"lightblue":            "#add8e6",
+	"lightcoral":           "#f08080",
+	"lightcyan":            "#e0ffff",
+	"lightgoldenrodyellow": "#fafad2",
+	"lightgray":            "#d3d3d3",
+	"lightgreen":           "#90ee90",
+	"lightgrey":            "#d3d3d3",
+	"lightpink":            "#ffb6c1",
+	"lightsalmon":          "#ffa07a",
+	"lightseagreen":        "#20b2aa",
+	"lightskyblue":         "#87cefa",
+	"lightslategray":       "#778899",
+	"lightslategrey":       "#778899",
+	"lightsteelblue":       "#b0c4de",
+	"lightyellow":          "#ffffe0",
+	"lime":                 "#00ff00",
+	"limegreen":            "#32cd32",
+	"linen":                "#faf0e6",
+	"maroon":               "#800000",
+	"mediumaquamarine":     "#66cdaa",
+	"mediumblue":           "#0000cd",
+	"mediumorchid":         "#ba55d3",
+	"mediumpurple":         "#9370db",
+	"mediumseagreen":       "#3cb371",
+	"mediumslateblue":      "#7b68ee",
+	"mediumspringgreen":    "#00fa9a",
+	"mediumturquoise":      "#48d1cc",
+	"mediumvioletred":      "#c71585",
+	"midnightblue":         "#191970",
+	"mintcream":            "#f5fffa",
+	"mistyrose":            "#ffe4e1",
+	"moccasin":             "#ffe4b5",
+	"navajowhite":          "#ffdead",
+	"navy":                 "#000080",
+	"oldlace":              "#fdf5e6",
+	"olive":                "#808000",
+	"olivedrab":            "#6b8e23",
+	"orange":               "#ffa500",
+	"orangered":            "#ff4500",
+	"orchid":               "#da70d6",
+	"palegoldenrod":        "#eee8aa",
+	"palegreen":            "#98fb98",
+	"paleturquoise":        "#afeeee",
+	"palevioletred":        "#db7093",
+	"papayawhip":           "#ffefd5",
+	"peachpuff":            "#ffdab9",
+	"peru":                 "#cd853f",
+	"pink":                 "#ffc0cb",
+	"plum":                 "#dda0dd",
+	"powderblue":           "#b0e0e6",
+	"rebeccapurple":        "#663399",
+	"rosybrown":            "#bc8f8f",
+	"royalblue":            "#4169e1",
+	"saddlebrown":          "#8b4513",
+	"salmon":               "#fa8072",
+	"sandybrown":           "#f4a460",
+	"seagreen":             "#2e8b57",
+	"seashell":             "#fff5ee",
+	"sienna":               "#a0522d",
+	"silver":               "#c0c0c0",
+	"skyblue":              "#87ceeb",
+	"slateblue":            "#6a5acd",
+	"slategray":            "#708090",
+	"slategrey":            "#708090",
+	"snow":                 "#fffafa",
+	"springgreen":          "#00ff7f",
+	"steelblue":            "#4682b4",
+	"tan":                  "#d2b48c",
+	"teal":                 "#008080",
+	"thistle":              "#d8bfd8",
+	"tomato":               "#ff6347",
+	"turquoise":            "#40e0d0",
+	"violet":               "#ee82ee",
+	"wheat":                "#f5deb3",
+	"whitesmoke":           "#f5f5f5",
+	"yellowgreen":          "#9acd32",
+}
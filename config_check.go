@@ -0,0 +1,392 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// configProblem is something in config.toml that couldn't be used
+type configProblem struct {
+	key  toml.Key // where it is, e.g. listColors.Work
+	msg  string
+	line int // line in config.toml, 0 when unknown
+}
+
+func newConfigProblem(key toml.Key, format string, args ...any) configProblem {
+	return configProblem{key: key, msg: fmt.Sprintf(format, args...)}
+}
+
+func (p configProblem) String() string {
+	if p.line > 0 {
+		return fmt.Sprintf("line %d: %s", p.line, p.msg)
+	}
+	return p.msg
+}
+
+// sortProblems orders problems by line, then by key, dropping duplicates
+func sortProblems(problems []configProblem) []configProblem {
+	sort.SliceStable(problems, func(i, j int) bool {
+		if problems[i].line != problems[j].line {
+			return problems[i].line < problems[j].line
+		}
+		if ki, kj := problems[i].key.String(), problems[j].key.String(); ki != kj {
+			return ki < kj
+		}
+		return problems[i].msg < problems[j].msg
+	})
+	var out []configProblem
+	for i, p := range problems {
+		if i == 0 || p.String() != problems[i-1].String() || p.key.String() != problems[i-1].key.String() {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// undecodedProblems reports keys in the file that don't match any setting
+func undecodedProblems(md toml.MetaData) []configProblem {
+	var problems []configProblem
+	undecoded := md.Undecoded()
+	for _, key := range undecoded {
+		// Only report the top of an unknown table, not every key inside it
+		if parent := key[:len(key)-1]; len(parent) > 0 && containsKey(undecoded, parent) {
+			continue
+		}
+		problems = append(problems, newConfigProblem(key, "unknown key %q", key.String()))
+	}
+	return problems
+}
+
+func containsKey(keys []toml.Key, want toml.Key) bool {
+	for _, k := range keys {
+		if k.String() == want.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// validateConfig checks settings the decoder accepts but the app can't use
+func validateConfig(config Config) []configProblem {
+	var problems []configProblem
+	switch strings.ToLower(config.Columns.Mode) {
+	case "", "fixed", "fill":
+	default:
+		problems = append(problems, newConfigProblem(toml.Key{"columns", "layout"},
+			"unknown column layout %q, expected \"fixed\" or \"fill\"", config.Columns.Mode))
+	}
+	for name, w := range config.Columns.Widths {
+		if w <= 0 {
+			problems = append(problems, newConfigProblem(toml.Key{"columns", "widths", name},
+				"width for list %q must be positive, got %d", name, w))
+		}
+	}
+	return problems
+}
+
+// listNameProblems reports list names in config that match no list in
+// Reminders, which usually means a typo
+func listNameProblems(config Config, lists []string) []configProblem {
+	known := make(map[string]bool, len(lists))
+	for _, name := range lists {
+		known[strings.ToLower(name)] = true
+	}
+	var problems []configProblem
+	check := func(name string, key toml.Key) {
+		if !known[strings.ToLower(name)] {
+			problems = append(problems, newConfigProblem(key, "no Reminders list named %q", name))
+		}
+	}
+	for name := range config.ListColors {
+		check(name, toml.Key{"listColors", name})
+	}
+	for name := range config.Columns.Widths {
+		check(name, toml.Key{"columns", "widths", name})
+	}
+	for name := range config.Urgency.Lists {
+		check(name, toml.Key{"urgency", "lists", name})
+	}
+	return problems
+}
+
+// withLines fills in the line of each problem from config.toml
+func withLines(problems []configProblem) []configProblem {
+	data, err := os.ReadFile(configPath())
+	if err != nil {
+		return sortProblems(problems)
+	}
+	for i := range problems {
+		// Fall back to the enclosing key, e.g. for a field left out of an
+		// entry, or entries written as an inline array
+		for key := problems[i].key; len(key) > 0 && problems[i].line == 0; key = key[:len(key)-1] {
+			problems[i].line = keyLine(data, key)
+		}
+	}
+	return sortProblems(problems)
+}
+
+// entryKey is the key of a field in the index'th entry of an array of
+// tables, e.g. status.widgets.2.command. keyLine counts [[...]] headers to
+// find it. Without a field it's the entry's header.
+func entryKey(array toml.Key, index int, field ...string) toml.Key {
+	key := append(slices.Clone(array), strconv.Itoa(index))
+	return append(key, field...)
+}
+
+// keyLine finds the line defining key in TOML source: the key's assignment
+// inside its table, or the table header itself. Entries of arrays of tables
+// are numbered from 0 as in entryKey. Returns 0 if not found.
+//
+// It only follows tables and keys, so the bodies of multi-line strings and
+// arrays are skipped rather than read as headers or assignments.
+func keyLine(data []byte, key toml.Key) int {
+	if len(key) == 0 {
+		return 0
+	}
+	var table toml.Key
+	entries := make(map[string]int) // [[...]] headers seen so far, by table
+	var open string                 // closing quotes of a multi-line string
+	var depth int                   // brackets still open in an array value
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if open != "" || depth > 0 {
+			open, depth = scanValue(text, open, depth)
+			continue
+		}
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		if strings.HasPrefix(text, "[") {
+			array := strings.HasPrefix(text, "[[")
+			header := strings.TrimPrefix(text, "[")
+			if array {
+				header = strings.TrimPrefix(header, "[")
+			}
+			table = splitKeyPath(header, ']')
+			if array {
+				name := table.String()
+				n := entries[name]
+				entries[name]++
+				table = append(table, strconv.Itoa(n))
+			}
+			if slices.Equal(table, key) {
+				return line
+			}
+			continue
+		}
+		at := unquotedIndex(text, '=')
+		if at < 0 {
+			continue
+		}
+		path := append(slices.Clone(table), splitKeyPath(text[:at], 0)...)
+		if slices.Equal(path, key) {
+			return line
+		}
+		open, depth = scanValue(text[at+1:], "", 0)
+	}
+	return 0
+}
+
+// splitKeyPath splits a dotted TOML key like `columns."Work.Projects"` into
+// its unquoted parts, splitting only on dots outside quotes. A non-zero end
+// stops it at that character outside quotes, e.g. a header's closing ].
+func splitKeyPath(path string, end byte) toml.Key {
+	var parts toml.Key
+	var part strings.Builder
+	for i := 0; i < len(path); i++ {
+		switch c := path[i]; {
+		case c == '"' || c == '\'':
+			j := closingQuote(path, i)
+			if j < 0 {
+				j = len(path)
+			}
+			quoted := path[i:min(j+1, len(path))]
+			if s, err := strconv.Unquote(quoted); c == '"' && err == nil {
+				part.WriteString(s)
+			} else {
+				part.WriteString(strings.Trim(quoted, string(c)))
+			}
+			i = j
+		case c == '.':
+			parts = append(parts, strings.TrimSpace(part.String()))
+			part.Reset()
+		case c == end && end != 0:
+			return append(parts, strings.TrimSpace(part.String()))
+		default:
+			part.WriteByte(c)
+		}
+	}
+	return append(parts, strings.TrimSpace(part.String()))
+}
+
+// unquotedIndex is the index of the first c in s outside quotes, or -1
+func unquotedIndex(s string, c byte) int {
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '"', '\'':
+			if i = closingQuote(s, i); i < 0 {
+				return -1
+			}
+		case c:
+			return i
+		}
+	}
+	return -1
+}
+
+// closingQuote is the index of the quote closing the single-line string
+// opened at s[start], or -1. Basic strings ("...") can escape quotes with a
+// backslash, literal strings ('...') can't.
+func closingQuote(s string, start int) int {
+	q := s[start]
+	for i := start + 1; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && q == '"':
+			i++
+		case s[i] == q:
+			return i
+		}
+	}
+	return -1
+}
+
+// scanValue follows a line of a TOML value to see whether it leaves a
+// multi-line string or array open for the next line. open is the closing
+// quotes of a multi-line string the line starts in, depth the number of
+// array brackets open before it.
+func scanValue(s, open string, depth int) (string, int) {
+	for i := 0; i < len(s); i++ {
+		if open != "" {
+			end := strings.Index(s[i:], open)
+			if end < 0 {
+				return open, depth
+			}
+			// Up to two more quotes can end the string's content
+			i += end + len(open) - 1
+			for i+1 < len(s) && s[i+1] == open[0] {
+				i++
+			}
+			open = ""
+			continue
+		}
+		switch c := s[i]; c {
+		case '#':
+			return "", depth
+		case '"', '\'':
+			if q := strings.Repeat(string(c), 3); strings.HasPrefix(s[i:], q) {
+				open = q
+				i += 2
+				continue
+			}
+			if i = closingQuote(s, i); i < 0 {
+				return "", depth
+			}
+		case '[', '{':
+			depth++
+		case ']', '}':
+			depth = max(depth-1, 0)
+		}
+	}
+	return open, depth
+}
+
+// checkConfig strictly reads config.toml, returning the config and every
+// problem found. The error is for a file that can't be parsed at all.
+func checkConfig() (Config, []configProblem, error) {
+	config := defaultConfig()
+	data, err := os.ReadFile(configPath())
+	if errors.Is(err, os.ErrNotExist) {
+		// Config file is optional
+		return config, nil, nil
+	}
+	if err != nil {
+		return config, nil, err
+	}
+
+	md, err := toml.Decode(string(data), &config)
+	if err != nil {
+		return defaultConfig(), nil, err
+	}
+	problems := undecodedProblems(md)
+	problems = append(problems, validateConfig(config)...)
+	return config, problems, nil
+}
+
+// configProblemsMsg carries the startup config check, once list names have
+// been checked against Reminders
+type configProblemsMsg []configProblem
+
+// checkListNamesCmd adds list name problems in config to those found while
+// loading it, in the background since it has to ask Reminders for its lists
+func checkListNamesCmd(config Config, problems []configProblem) tea.Cmd {
+	problems = slices.Clone(problems)
+	return func() tea.Msg {
+		if lists, err := reminderListNames(); err == nil {
+			problems = append(problems, listNameProblems(config, lists)...)
+		}
+		return configProblemsMsg(withLines(problems))
+	}
+}
+
+// reminderListNames returns every list in Reminders, including empty ones
+// that never show up in reminder output
+func reminderListNames() ([]string, error) {
+	output, err := exec.Command("reminders", "show-lists").Output()
+	if err != nil {
+		return nil, err
+	}
+	var lists []string
+	for _, line := range strings.Split(string(output), "\n") {
+		if name := strings.TrimSpace(line); name != "" {
+			lists = append(lists, name)
+		}
+	}
+	return lists, nil
+}
+
+// runConfigCheck implements `reminders-dashboard config check`. It prints
+// every problem in config.toml and returns the process exit code.
+func runConfigCheck() int {
+	path := configPath()
+	config, problems, err := checkConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", path, err)
+		return 1
+	}
+
+	// Checking theme, colors and keys applies them, which is harmless here.
+	// Start from a blank config so the theme settings are always checked.
+	activeConfig = Config{}
+	problems = append(problems, applyConfig(config)...)
+	if lists, err := reminderListNames(); err == nil {
+		problems = append(problems, listNameProblems(config, lists)...)
+	} else {
+		fmt.Fprintf(os.Stderr, "%s: skipping list name checks: %v\n", path, err)
+	}
+
+	problems = withLines(problems)
+	for _, p := range problems {
+		if p.line > 0 {
+			fmt.Printf("%s:%d: %s\n", path, p.line, p.msg)
+		} else {
+			fmt.Printf("%s: %s\n", path, p.msg)
+		}
+	}
+	if len(problems) > 0 {
+		return 1
+	}
+	fmt.Printf("%s: ok\n", path)
+	return 0
+}
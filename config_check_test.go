@@ -0,0 +1,99 @@
+package main
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+)
+
+const keyLineConfig = `# Reminders dashboard
+theme = "dark"
+
+[columns."Work.Projects"]
+color = "#ff8800"
+
+[columns.'Home']
+color = "#00ff88" # comment with = and [brackets]
+
+[help]
+text = """
+[not.a.header]
+color = "red"
+"""
+quotes = '''
+hidden = true'''
+after = 1
+
+[lists]
+order = [
+	"Work",
+	"[Home]",
+	"a = b",
+]
+hidden = ["Old", "Archive"]
+
+[[status.widgets]]
+command = "date"
+
+[[status.widgets]]
+command = "uptime"
+"key.with.dots" = true
+`
+
+func TestKeyLine(t *testing.T) {
+	tests := []struct {
+		name string
+		key  toml.Key
+		want int
+	}{
+		{"top-level key", toml.Key{"theme"}, 2},
+		{"quoted header with a dot", toml.Key{"columns", "Work.Projects"}, 4},
+		{"key in a quoted header", toml.Key{"columns", "Work.Projects", "color"}, 5},
+		{"not split on the quoted dot", toml.Key{"columns", "Work", "Projects"}, 0},
+		{"literal quoted header", toml.Key{"columns", "Home", "color"}, 8},
+		{"multi-line string", toml.Key{"help", "text"}, 11},
+		{"header inside a multi-line string", toml.Key{"not", "a", "header"}, 0},
+		{"key inside a multi-line string", toml.Key{"help", "color"}, 0},
+		{"literal multi-line string", toml.Key{"help", "quotes"}, 15},
+		{"key inside a literal multi-line string", toml.Key{"help", "hidden"}, 0},
+		{"after multi-line strings", toml.Key{"help", "after"}, 17},
+		{"multi-line array", toml.Key{"lists", "order"}, 20},
+		{"key inside a multi-line array", toml.Key{"lists", "a"}, 0},
+		{"after a multi-line array", toml.Key{"lists", "hidden"}, 25},
+		{"first array of tables entry", toml.Key{"status", "widgets", "0"}, 27},
+		{"field of the first entry", toml.Key{"status", "widgets", "0", "command"}, 28},
+		{"field of the second entry", toml.Key{"status", "widgets", "1", "command"}, 31},
+		{"quoted key with dots", toml.Key{"status", "widgets", "1", "key.with.dots"}, 32},
+		{"missing entry", toml.Key{"status", "widgets", "2"}, 0},
+		{"empty key", nil, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := keyLine([]byte(keyLineConfig), tt.key); got != tt.want {
+				t.Errorf("keyLine(%v) = %d, want %d", tt.key, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitKeyPath(t *testing.T) {
+	tests := []struct {
+		in   string
+		end  byte
+		want toml.Key
+	}{
+		{"theme ", 0, toml.Key{"theme"}},
+		{`columns."My List"`, 0, toml.Key{"columns", "My List"}},
+		{`columns . "Work.Projects" `, 0, toml.Key{"columns", "Work.Projects"}},
+		{`columns.'C:\dir'`, 0, toml.Key{"columns", `C:\dir`}},
+		{`"say \"hi\"".x`, 0, toml.Key{`say "hi"`, "x"}},
+		{`columns."a]b"] # note`, ']', toml.Key{"columns", "a]b"}},
+	}
+	for _, tt := range tests {
+		got := splitKeyPath(tt.in, tt.end)
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("splitKeyPath(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -35,11 +35,63 @@ func (d delegateKeyMap) FullHelp() [][]key.Binding {
 type customItemDelegate struct {
 	list.DefaultDelegate
 	keys *delegateKeyMap
+
+	// compact renders each reminder on a single line
+	compact bool
+	// hideListTag omits the list name in compact mode, for columns where it's redundant
+	hideListTag bool
+	// hideSelection draws the selected item like the others, for unfocused columns
+	hideSelection bool
+	// detailed adds a line with every other field, used by the zoomed column
+	detailed bool
+}
+
+// Height is one line per reminder in compact mode, title and description
+// otherwise, plus a line of details when detailed
+func (d customItemDelegate) Height() int {
+	if d.compact {
+		return 1
+	}
+	if d.detailed {
+		return d.DefaultDelegate.Height() + 1
+	}
+	return d.DefaultDelegate.Height()
+}
+
+// Spacing drops the blank line between reminders in compact mode
+func (d customItemDelegate) Spacing() int {
+	if d.compact {
+		return 0
+	}
+	return d.DefaultDelegate.Spacing()
 }
 
 func newItemDelegate(keys *delegateKeyMap) customItemDelegate {
 	d := list.NewDefaultDelegate()
 
+	d.UpdateFunc = func(msg tea.Msg, m *list.Model) tea.Cmd {
+		// Removed "You chose X" functionality
+		return nil
+	}
+
+	d.ShortHelpFunc = func() []key.Binding {
+		return []key.Binding{}
+	}
+
+	d.FullHelpFunc = func() [][]key.Binding {
+		return [][]key.Binding{{}}
+	}
+
+	cd := customItemDelegate{
+		DefaultDelegate: d,
+		keys:            keys,
+	}
+	cd.applyTheme()
+	return cd
+}
+
+// applyTheme rebuilds the item styles from the current theme
+func (d *customItemDelegate) applyTheme() {
 	// Customize delegate styles with theme colors - remove Foreground from titles
 	// so we can apply it selectively
 	d.Styles.NormalTitle = lipgloss.NewStyle().
@@ -71,40 +123,32 @@ func newItemDelegate(keys *delegateKeyMap) customItemDelegate {
 	d.Styles.FilterMatch = lipgloss.NewStyle().
 		Foreground(theme.Yellow()).
 		Underline(true)
-
-	d.UpdateFunc = func(msg tea.Msg, m *list.Model) tea.Cmd {
-		// Removed "You chose X" functionality
-		return nil
-	}
-
-	d.ShortHelpFunc = func() []key.Binding {
-		return []key.Binding{}
-	}
-
-	d.FullHelpFunc = func() [][]key.Binding {
-		return [][]key.Binding{{}}
-	}
-
-	return customItemDelegate{
-		DefaultDelegate: d,
-		keys:            keys,
-	}
 }
 
 // Render renders the item with custom coloring that works with filtering
 func (d customItemDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	if h, ok := listItem.(headerItem); ok {
+		d.renderHeader(w, m, h)
+		return
+	}
+
 	i, ok := listItem.(item)
 	if !ok {
 		return
 	}
 
-	str := i.Title()
-	desc := i.Description()
-
 	if m.Width() <= 0 {
 		return
 	}
 
+	if d.compact {
+		d.renderCompact(w, m, index, i)
+		return
+	}
+
+	str := i.Title()
+	desc := i.Description()
+
 	// Determine styles based on item state
 	var (
 		isSelected  = index == m.Index()
@@ -136,7 +180,7 @@ func (d customItemDelegate) Render(w io.Writer, m list.Model, index int, listIte
 
 	if strings.HasPrefix(str, "● ") && i.color != "" {
 		// Has colored bullet
-		bulletColor := lipgloss.Color(i.color)
+		bulletColor := colorOr(i.color, theme.BrightBlack())
 		bullet := lipgloss.NewStyle().Foreground(bulletColor).Render("●")
 		titleText := str[len("● "):]
 
@@ -212,9 +256,156 @@ func (d customItemDelegate) Render(w io.Writer, m list.Model, index int, listIte
 	maxW := m.Width()
 	renderedTitle = lipgloss.NewStyle().MaxWidth(maxW).Render(renderedTitle)
 	renderedDesc = lipgloss.NewStyle().MaxWidth(maxW).Render(renderedDesc)
+	if d.detailed {
+		renderedDetails := lipgloss.NewStyle().MaxWidth(maxW).Render(d.renderDetails(i, isSelected))
+		fmt.Fprintf(w, "%s\n%s\n%s", renderedTitle, renderedDesc, renderedDetails)
+		return
+	}
 	fmt.Fprintf(w, "%s\n%s", renderedTitle, renderedDesc)
 }
 
+// renderDetails renders the fields not in the title or description: full
+// due and start dates, priority, list and notes
+func (d customItemDelegate) renderDetails(i item, isSelected bool) string {
+	labelStyle := lipgloss.NewStyle().Foreground(theme.BrightBlack())
+	valueStyle := lipgloss.NewStyle().Foreground(theme.Fg())
+
+	var fields []string
+	addField := func(label, value string) {
+		if value != "" {
+			fields = append(fields, labelStyle.Render(label+" ")+valueStyle.Render(value))
+		}
+	}
+	if !i.parsedDate.IsZero() {
+		if i.allDay {
+			addField("Due", dates.fullDate(i.parsedDate))
+		} else {
+			addField("Due", dates.dateTime(i.parsedDate.In(dueLocation)))
+		}
+	}
+	if !i.startDate.IsZero() {
+		addField("Start", dates.dateTime(i.startDate.In(dueLocation)))
+	}
+	if !i.parsedDate.IsZero() {
+		if f, ok := forecastFor(i.parsedDate); ok {
+			addField("Weather", f.summary())
+		}
+	}
+	addField("Priority", priorityLabel(i.priority))
+	addField("List", i.listName)
+	addField("Notes", strings.Join(strings.Fields(i.notes), " "))
+
+	line := strings.Join(fields, labelStyle.Render("  "))
+	if isSelected {
+		return lipgloss.NewStyle().Foreground(theme.BrightCyan()).Render("│") + " " + line
+	}
+	return "  " + line
+}
+
+// renderCompact renders a reminder on one line: bullet and title on the
+// left, urgency and list tag right-aligned, with the title truncated to fit
+func (d customItemDelegate) renderCompact(w io.Writer, m list.Model, index int, i item) {
+	maxW := m.Width()
+	isSelected := index == m.Index() && !d.hideSelection
+	matches := m.MatchesForItem(index)
+
+	// Left edge: selection border or padding
+	prefix := "  "
+	titleFg := theme.Fg()
+	if isSelected {
+		prefix = lipgloss.NewStyle().Foreground(theme.BrightCyan()).Render("│") + " "
+		titleFg = theme.BrightCyan()
+	}
+	if i.color != "" {
+		prefix += lipgloss.NewStyle().Foreground(colorOr(i.color, theme.BrightBlack())).Render("●") + " "
+	}
+
+	// Right side: urgency text and list tag
+	var rightParts []string
+	if i.urgencyText != "" {
+		rightParts = append(rightParts, lipgloss.NewStyle().Foreground(urgencyColorToTheme(i.urgencyColor)).Render(i.urgencyText))
+	}
+	if !d.hideListTag && i.listName != "" {
+		rightParts = append(rightParts, lipgloss.NewStyle().Foreground(theme.BrightBlack()).Render("• "+i.listName))
+	}
+	right := strings.Join(rightParts, " ")
+
+	// Drop the right side entirely if there's no room for a useful title
+	const minTitleWidth = 8
+	available := maxW - lipgloss.Width(prefix) - lipgloss.Width(right) - 1
+	if available < minTitleWidth {
+		right = ""
+		available = maxW - lipgloss.Width(prefix)
+	}
+
+	title := truncateText(i.title, available)
+	var titleStyled string
+	if len(matches) > 0 {
+		titleStyled = d.applyFilterMatches(title, matches, titleFg)
+	} else {
+		titleStyled = lipgloss.NewStyle().Foreground(titleFg).Render(title)
+	}
+
+	line := prefix + titleStyled
+	if right != "" {
+		gap := max(maxW-lipgloss.Width(line)-lipgloss.Width(right), 1)
+		line += strings.Repeat(" ", gap) + right
+	}
+	fmt.Fprint(w, lipgloss.NewStyle().MaxWidth(maxW).Render(line))
+}
+
+// truncateText shortens s to at most width cells, ending with "…" if cut
+func truncateText(s string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	if lipgloss.Width(s) <= width {
+		return s
+	}
+	runes := []rune(s)
+	for len(runes) > 0 && lipgloss.Width(string(runes))+1 > width {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes) + "…"
+}
+
+// renderHeader renders a section header row followed by a dim rule, filling
+// the same two lines a reminder takes. In compact mode only the header line
+// is drawn.
+func (d customItemDelegate) renderHeader(w io.Writer, m list.Model, h headerItem) {
+	maxW := m.Width()
+	if maxW <= 0 {
+		return
+	}
+	header := lipgloss.NewStyle().
+		Foreground(theme.Blue()).
+		Bold(true).
+		Padding(0, 0, 0, 2).
+		MaxWidth(maxW).
+		Render(h.Title())
+	if !h.day.IsZero() {
+		// Weather for the day, to plan around
+		if f, ok := forecastFor(h.day); ok {
+			header += lipgloss.NewStyle().Foreground(theme.BrightBlack()).Render("  " + f.summary())
+			header = lipgloss.NewStyle().MaxWidth(maxW).Render(header)
+		}
+	}
+	if d.compact {
+		fmt.Fprint(w, header)
+		return
+	}
+	ruleWidth := maxW - 2
+	if ruleWidth < 0 {
+		ruleWidth = 0
+	}
+	rule := lipgloss.NewStyle().
+		Foreground(theme.BrightBlack()).
+		Padding(0, 0, 0, 2).
+		MaxWidth(maxW).
+		Render(strings.Repeat("─", ruleWidth))
+	fmt.Fprintf(w, "%s\n%s", header, rule)
+}
+
 // applyFilterMatches applies yellow highlighting to matched character ranges
 func (d customItemDelegate) applyFilterMatches(text string, matches []int, baseFg lipgloss.TerminalColor) string {
 	if len(matches) == 0 {
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"sort"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sahilm/fuzzy"
+)
+
+// How long to wait after the last keystroke before re-running the filter
+const filterDebounce = 150 * time.Millisecond
+
+// filterDebounceMsg is sent once typing in a filter input pauses. The tag
+// is compared against the view's current tag so stale ticks are ignored.
+type filterDebounceMsg struct {
+	tag int
+}
+
+func debounceFilter(tag int) tea.Cmd {
+	return tea.Tick(filterDebounce, func(time.Time) tea.Msg {
+		return filterDebounceMsg{tag: tag}
+	})
+}
+
+// searchRank controls how filtered results are ordered
+type searchRank int
+
+const (
+	rankByOrder     searchRank = iota // the view's sort order (same as unfiltered)
+	rankByRelevance                   // fuzzy match score, sort order breaks ties
+)
+
+func (r searchRank) String() string {
+	if r == rankByRelevance {
+		return "relevance"
+	}
+	return "sort order"
+}
+
+func (r searchRank) toggle() searchRank {
+	if r == rankByRelevance {
+		return rankByOrder
+	}
+	return rankByRelevance
+}
+
+// filterItems fuzzy matches query against item titles and orders the result
+// according to rank and order. An empty query keeps every item in sort order.
+func filterItems(query string, items []item, rank searchRank, order sortOrder) []item {
+	now := currentTime()
+	if query == "" {
+		filtered := make([]item, len(items))
+		copy(filtered, items)
+		sort.SliceStable(filtered, func(i, j int) bool {
+			return order.less(filtered[i], filtered[j], now)
+		})
+		return filtered
+	}
+
+	// Fuzzy search across all items
+	searchStrings := make([]string, len(items))
+	for i, it := range items {
+		searchStrings[i] = it.title
+	}
+	matches := fuzzy.Find(query, searchStrings)
+
+	filtered := make([]item, len(matches))
+	scores := make([]int, len(matches))
+	for i, match := range matches {
+		filtered[i] = items[match.Index]
+		scores[i] = match.Score
+	}
+
+	// Sort the matches and their scores together
+	sort.Stable(rankedItems{items: filtered, scores: scores, rank: rank, order: order, now: now})
+	return filtered
+}
+
+// rankedItems sorts matched items and keeps their fuzzy scores aligned
+type rankedItems struct {
+	items  []item
+	scores []int
+	rank   searchRank
+	order  sortOrder
+	now    time.Time
+}
+
+func (r rankedItems) Len() int { return len(r.items) }
+
+func (r rankedItems) Swap(i, j int) {
+	r.items[i], r.items[j] = r.items[j], r.items[i]
+	r.scores[i], r.scores[j] = r.scores[j], r.scores[i]
+}
+
+func (r rankedItems) Less(i, j int) bool {
+	if r.rank == rankByRelevance && r.scores[i] != r.scores[j] {
+		// Higher score is a better match
+		return r.scores[i] > r.scores[j]
+	}
+	return r.order.less(r.items[i], r.items[j], r.now)
+}
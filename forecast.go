@@ -0,0 +1,106 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Days shown in the forecast panel
+const forecastPanelDays = 3
+
+// forecastDay is one day of the weather forecast, in both unit systems
+type forecastDay struct {
+	Date         string `json:"date"` // 2006-01-02
+	Description  string `json:"description"`
+	MaxC         string `json:"maxC"`
+	MinC         string `json:"minC"`
+	MaxF         string `json:"maxF"`
+	MinF         string `json:"minF"`
+	ChanceOfRain int    `json:"chanceOfRain"` // highest of the day, percent
+}
+
+// Latest forecast, set when a weather report arrives. Day headers and the
+// details row read it when rendering.
+var forecast []forecastDay
+
+// forecastDays converts wttr.in's forecast. The description is the one for
+// midday, falling back to the first hour given.
+func forecastDays(days []WeatherDay) []forecastDay {
+	var out []forecastDay
+	for _, d := range days {
+		fd := forecastDay{Date: d.Date, MaxC: d.MaxtempC, MinC: d.MintempC, MaxF: d.MaxtempF, MinF: d.MintempF}
+		for i, h := range d.Hourly {
+			if (i == 0 || h.Time == "1200") && len(h.WeatherDesc) > 0 {
+				fd.Description = strings.TrimSpace(h.WeatherDesc[0].Value)
+			}
+			if n, err := strconv.Atoi(h.ChanceOfRain); err == nil && n > fd.ChanceOfRain {
+				fd.ChanceOfRain = n
+			}
+		}
+		out = append(out, fd)
+	}
+	return out
+}
+
+// forecastFor returns the forecast for t's day, if there is one
+func forecastFor(t time.Time) (forecastDay, bool) {
+	date := t.In(dueLocation).Format(time.DateOnly)
+	for _, d := range forecast {
+		if d.Date == date {
+			return d, true
+		}
+	}
+	return forecastDay{}, false
+}
+
+// summary describes the day compactly, e.g. "Light rain 12°/5° ☂80%"
+func (d forecastDay) summary() string {
+	high, low := d.MaxC, d.MinC
+	if weather.imperial {
+		high, low = d.MaxF, d.MinF
+	}
+	var parts []string
+	if d.Description != "" {
+		parts = append(parts, d.Description)
+	}
+	parts = append(parts, high+"°/"+low+"°")
+	if d.ChanceOfRain > 0 {
+		parts = append(parts, "☂"+strconv.Itoa(d.ChanceOfRain)+"%")
+	}
+	return strings.Join(parts, " ")
+}
+
+// forecastPanelView renders the next few days of forecast on one line
+func forecastPanelView(width int, now time.Time) string {
+	dimStyle := lipgloss.NewStyle().Foreground(theme.BrightBlack())
+	dayStyle := lipgloss.NewStyle().Foreground(theme.BrightCyan())
+	textStyle := lipgloss.NewStyle().Foreground(theme.BrightGreen())
+
+	var days []string
+	for i := 0; i < forecastPanelDays; i++ {
+		day := time.Date(now.Year(), now.Month(), now.Day()+i, 12, 0, 0, 0, now.Location())
+		d, ok := forecastFor(day)
+		if !ok {
+			continue
+		}
+		var label string
+		switch i {
+		case 0:
+			label = dates.locale.today
+		case 1:
+			label = dates.locale.tomorrow
+		default:
+			label = dates.weekday(day)
+		}
+		days = append(days, dayStyle.Render(label)+" "+textStyle.Render(d.summary()))
+	}
+
+	line := dimStyle.Render("No forecast available")
+	if len(days) > 0 {
+		line = strings.Join(days, dimStyle.Render("  │  "))
+	}
+	return lipgloss.NewStyle().PaddingLeft(2).MaxWidth(width).Render(line)
+}
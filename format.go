@@ -0,0 +1,448 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+)
+
+// formatConfig is the [format] table in config.toml. Everything is optional:
+//
+//	[format]
+//	locale = "de"          # en, de, fr, es; defaults to $LC_ALL / $LC_TIME / $LANG
+//	clock = "12h"          # or "24h"
+//	weekStart = "sunday"   # first day of the week, default monday
+//	date = "Jan 2"         # Go time layouts, month and day names get translated
+//	dateYear = "Jan 2, 2006"
+//	longDate = "Monday, January 2, 2006"
+//	day = "Monday, Jan 2"
+//	dayYear = "Monday, Jan 2, 2006"
+//	time = "15:04:05"
+type formatConfig struct {
+	Locale    string `toml:"locale"`
+	Clock     string `toml:"clock"`
+	WeekStart string `toml:"weekStart"`
+	formatLayouts
+}
+
+// formatLayouts are Go time layouts for each kind of date the app shows
+type formatLayouts struct {
+	Date     string `toml:"date"`     // due date in descriptions
+	DateYear string `toml:"dateYear"` // due date not in the current year
+	LongDate string `toml:"longDate"` // footer date
+	Day      string `toml:"day"`      // day section headers
+	DayYear  string `toml:"dayYear"`  // day section headers not in the current year
+	Time     string `toml:"time"`     // footer clock
+}
+
+// merged fills the layouts left empty in l from defaults
+func (l formatLayouts) merged(defaults formatLayouts) formatLayouts {
+	pick := func(s, fallback string) string {
+		if s != "" {
+			return s
+		}
+		return fallback
+	}
+	return formatLayouts{
+		Date:     pick(l.Date, defaults.Date),
+		DateYear: pick(l.DateYear, defaults.DateYear),
+		LongDate: pick(l.LongDate, defaults.LongDate),
+		Day:      pick(l.Day, defaults.Day),
+		DayYear:  pick(l.DayYear, defaults.DayYear),
+		Time:     pick(l.Time, defaults.Time),
+	}
+}
+
+// locale holds the words and default layouts for one language
+type locale struct {
+	months      [12]string
+	shortMonths [12]string
+	days        [7]string // starting with Sunday, like time.Weekday
+	shortDays   [7]string
+	amPM        [2]string // before and after noon, for 12h clocks
+
+	today     string
+	tomorrow  string
+	overdue   string
+	dueToday  string // all-day reminders due today
+	noDueDate string
+	dueIn     string    // "Due in %s"
+	overdueN  [2]string // "%d overdue", singular and plural
+	dueTodayN [2]string // "%d due today"
+	nextDue   string    // "next: %s", the next reminder in the day summary
+	atTime    string    // "at %s", a time of day
+	minutes   [2]string // singular, plural
+	hours     [2]string
+	dayUnit   [2]string
+	weeks     [2]string
+
+	layouts formatLayouts
+}
+
+var locales = map[string]locale{
+	"en": {
+		months:      [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+		shortMonths: [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		days:        [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		shortDays:   [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		amPM:        [2]string{"AM", "PM"},
+		today:       "Today",
+		tomorrow:    "Tomorrow",
+		overdue:     "Overdue",
+		dueToday:    "Due today",
+		noDueDate:   "No due date",
+		dueIn:       "Due in %s",
+		overdueN:    [2]string{"%d overdue", "%d overdue"},
+		dueTodayN:   [2]string{"%d due today", "%d due today"},
+		nextDue:     "next: %s",
+		atTime:      "at %s",
+		minutes:     [2]string{"minute", "minutes"},
+		hours:       [2]string{"hour", "hours"},
+		dayUnit:     [2]string{"day", "days"},
+		weeks:       [2]string{"week", "weeks"},
+		layouts: formatLayouts{
+			Date:     "Jan 2",
+			DateYear: "Jan 2, 2006",
+			LongDate: "Monday, January 2, 2006",
+			Day:      "Monday, Jan 2",
+			DayYear:  "Monday, Jan 2, 2006",
+		},
+	},
+	"de": {
+		months:      [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		shortMonths: [12]string{"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni", "Juli", "Aug.", "Sept.", "Okt.", "Nov.", "Dez."},
+		days:        [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+		shortDays:   [7]string{"So.", "Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa."},
+		amPM:        [2]string{"AM", "PM"},
+		today:       "Heute",
+		tomorrow:    "Morgen",
+		overdue:     "Überfällig",
+		dueToday:    "Heute fällig",
+		noDueDate:   "Kein Fälligkeitsdatum",
+		dueIn:       "Fällig in %s",
+		overdueN:    [2]string{"%d überfällig", "%d überfällig"},
+		dueTodayN:   [2]string{"%d heute fällig", "%d heute fällig"},
+		nextDue:     "als Nächstes: %s",
+		atTime:      "um %s",
+		minutes:     [2]string{"Minute", "Minuten"},
+		hours:       [2]string{"Stunde", "Stunden"},
+		dayUnit:     [2]string{"Tag", "Tagen"},
+		weeks:       [2]string{"Woche", "Wochen"},
+		layouts: formatLayouts{
+			Date:     "2. Jan",
+			DateYear: "2. Jan 2006",
+			LongDate: "Monday, 2. January 2006",
+			Day:      "Monday, 2. Jan",
+			DayYear:  "Monday, 2. Jan 2006",
+		},
+	},
+	"fr": {
+		months:      [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		shortMonths: [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+		days:        [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		shortDays:   [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+		amPM:        [2]string{"AM", "PM"},
+		today:       "Aujourd'hui",
+		tomorrow:    "Demain",
+		overdue:     "En retard",
+		dueToday:    "Pour aujourd'hui",
+		noDueDate:   "Sans échéance",
+		dueIn:       "Dans %s",
+		overdueN:    [2]string{"%d rappel en retard", "%d rappels en retard"},
+		dueTodayN:   [2]string{"%d pour aujourd'hui", "%d pour aujourd'hui"},
+		nextDue:     "ensuite : %s",
+		atTime:      "à %s",
+		minutes:     [2]string{"minute", "minutes"},
+		hours:       [2]string{"heure", "heures"},
+		dayUnit:     [2]string{"jour", "jours"},
+		weeks:       [2]string{"semaine", "semaines"},
+		layouts: formatLayouts{
+			Date:     "2 Jan",
+			DateYear: "2 Jan 2006",
+			LongDate: "Monday 2 January 2006",
+			Day:      "Monday 2 Jan",
+			DayYear:  "Monday 2 Jan 2006",
+		},
+	},
+	"es": {
+		months:      [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		shortMonths: [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sept", "oct", "nov", "dic"},
+		days:        [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		shortDays:   [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+		amPM:        [2]string{"a. m.", "p. m."},
+		today:       "Hoy",
+		tomorrow:    "Mañana",
+		overdue:     "Vencido",
+		dueToday:    "Vence hoy",
+		noDueDate:   "Sin fecha",
+		dueIn:       "Vence en %s",
+		overdueN:    [2]string{"%d vencido", "%d vencidos"},
+		dueTodayN:   [2]string{"%d vence hoy", "%d vencen hoy"},
+		nextDue:     "siguiente: %s",
+		atTime:      "a las %s",
+		minutes:     [2]string{"minuto", "minutos"},
+		hours:       [2]string{"hora", "horas"},
+		dayUnit:     [2]string{"día", "días"},
+		weeks:       [2]string{"semana", "semanas"},
+		layouts: formatLayouts{
+			Date:     "2 Jan",
+			DateYear: "2 Jan 2006",
+			LongDate: "Monday, 2 January 2006",
+			Day:      "Monday, 2 Jan",
+			DayYear:  "Monday, 2 Jan 2006",
+		},
+	},
+}
+
+// dateFormatter formats dates and relative times for the configured locale
+type dateFormatter struct {
+	locale    locale
+	layouts   formatLayouts
+	clock12   bool
+	weekStart time.Weekday
+}
+
+// Active date formatting (set by loadConfig in reminders.go)
+var dates = newDateFormatter(locales["en"], formatLayouts{}, false, time.Monday)
+
+func newDateFormatter(loc locale, layouts formatLayouts, clock12 bool, weekStart time.Weekday) dateFormatter {
+	clock := "15:04:05"
+	if clock12 {
+		clock = "3:04:05 PM"
+	}
+	defaults := loc.layouts
+	defaults.Time = clock
+
+	return dateFormatter{
+		locale:    loc,
+		layouts:   layouts.merged(defaults),
+		clock12:   clock12,
+		weekStart: weekStart,
+	}
+}
+
+// format is time.Format with month and day names and AM/PM in the locale.
+// Only the layout's name fields are translated, so literal text in a custom
+// layout is left as written.
+func (f dateFormatter) format(t time.Time, layout string) string {
+	var b strings.Builder
+	start := 0 // first byte of layout not written yet
+	for i := 0; i < len(layout); {
+		name, n := f.nameField(t, layout[i:])
+		if n == 0 {
+			i++
+			continue
+		}
+		b.WriteString(t.Format(layout[start:i]))
+		b.WriteString(name)
+		i += n
+		start = i
+	}
+	b.WriteString(t.Format(layout[start:]))
+	return b.String()
+}
+
+// nameField translates the name field at the start of layout, returning it
+// and its length in the layout, or 0 when layout doesn't start with one.
+// Fields are recognized the way time.Format does.
+func (f dateFormatter) nameField(t time.Time, layout string) (string, int) {
+	switch {
+	case strings.HasPrefix(layout, "January"):
+		return f.locale.months[t.Month()-1], len("January")
+	case strings.HasPrefix(layout, "Jan"):
+		return f.locale.shortMonths[t.Month()-1], len("Jan")
+	case strings.HasPrefix(layout, "Monday"):
+		return f.locale.days[t.Weekday()], len("Monday")
+	case strings.HasPrefix(layout, "Mon"):
+		return f.locale.shortDays[t.Weekday()], len("Mon")
+	case strings.HasPrefix(layout, "PM"):
+		return f.locale.amPM[t.Hour()/12], len("PM")
+	case strings.HasPrefix(layout, "pm"):
+		return strings.ToLower(f.locale.amPM[t.Hour()/12]), len("pm")
+	}
+	return "", 0
+}
+
+// date formats a due date, adding the year when it isn't the current one
+func (f dateFormatter) date(t, now time.Time) string {
+	if t.Year() == now.Year() {
+		return f.format(t, f.layouts.Date)
+	}
+	return f.format(t, f.layouts.DateYear)
+}
+
+// day formats a day section header, adding the year when it isn't the current one
+func (f dateFormatter) day(t, now time.Time) string {
+	if t.Year() == now.Year() {
+		return f.format(t, f.layouts.Day)
+	}
+	return f.format(t, f.layouts.DayYear)
+}
+
+func (f dateFormatter) longDate(t time.Time) string {
+	return f.format(t, f.layouts.LongDate)
+}
+
+func (f dateFormatter) clock(t time.Time) string {
+	return f.format(t, f.layouts.Time)
+}
+
+// fullDate formats a date with its weekday and year, for the details row
+func (f dateFormatter) fullDate(t time.Time) string {
+	return f.format(t, "Mon "+f.layouts.DateYear)
+}
+
+// dateTime is fullDate with the time of day
+func (f dateFormatter) dateTime(t time.Time) string {
+	return f.fullDate(t) + " " + f.timeOfDay(t)
+}
+
+// timeOfDay formats the hour and minute of t on the configured clock
+func (f dateFormatter) timeOfDay(t time.Time) string {
+	if f.clock12 {
+		return f.format(t, "3:04 PM")
+	}
+	return t.Format("15:04")
+}
+
+// weekday names t's day of the week
+func (f dateFormatter) weekday(t time.Time) string {
+	return f.locale.days[t.Weekday()]
+}
+
+// startOfWeek returns midnight on the first day of t's week
+func (f dateFormatter) startOfWeek(t time.Time) time.Time {
+	offset := (int(t.Weekday()) - int(f.weekStart) + 7) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, t.Location())
+}
+
+// sameWeek reports whether a and b fall in the same week
+func (f dateFormatter) sameWeek(a, b time.Time) bool {
+	return f.startOfWeek(a).Equal(f.startOfWeek(b.In(a.Location())))
+}
+
+// count formats n with the singular or plural unit
+func count(n int, unit [2]string) string {
+	if n == 1 {
+		return "1 " + unit[0]
+	}
+	return fmt.Sprintf("%d %s", n, unit[1])
+}
+
+// dueIn describes a time until due, e.g. "Due in 1 week 2 days". Weeks show
+// days, shorter spans show days and hours, the last hour counts down in
+// minutes, and zero parts are left out.
+func (f dateFormatter) dueIn(diff time.Duration) string {
+	days := int(diff.Hours() / 24)
+	hours := int(diff.Hours()) % 24
+
+	var parts []string
+	switch {
+	case diff < time.Hour:
+		// The last minute still reads "1 minute" until it is due
+		parts = append(parts, count(max(int(diff.Minutes()), 1), f.locale.minutes))
+	case days == 0:
+		// Less than a day
+		parts = append(parts, count(hours, f.locale.hours))
+	case days < 7:
+		parts = append(parts, count(days, f.locale.dayUnit))
+		if hours > 0 {
+			parts = append(parts, count(hours, f.locale.hours))
+		}
+	default:
+		// Format as weeks and days
+		parts = append(parts, count(days/7, f.locale.weeks))
+		if days%7 > 0 {
+			parts = append(parts, count(days%7, f.locale.dayUnit))
+		}
+	}
+	return fmt.Sprintf(f.locale.dueIn, strings.Join(parts, " "))
+}
+
+// counted formats n into the singular or plural form of a phrase such as
+// "%d overdue"
+func counted(n int, forms [2]string) string {
+	if n == 1 {
+		return fmt.Sprintf(forms[0], n)
+	}
+	return fmt.Sprintf(forms[1], n)
+}
+
+// localeFromEnv picks the language from the usual locale variables, e.g.
+// "de" from LANG=de_DE.UTF-8
+func localeFromEnv() string {
+	for _, name := range []string{"LC_ALL", "LC_TIME", "LANG"} {
+		if v := os.Getenv(name); v != "" && v != "C" && v != "POSIX" {
+			return v
+		}
+	}
+	return ""
+}
+
+// localeLanguage reduces a locale like "de_DE.UTF-8" or "fr-CA" to its language
+func localeLanguage(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if i := strings.IndexAny(name, "_-.@"); i >= 0 {
+		name = name[:i]
+	}
+	return name
+}
+
+// parseWeekday accepts English weekday names, full or abbreviated
+func parseWeekday(name string) (time.Weekday, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		full := strings.ToLower(d.String())
+		if name == full || name == full[:3] {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+// configureFormat sets the active date formatting from config, reporting
+// settings it doesn't understand
+func configureFormat(config formatConfig) []configProblem {
+	var problems []configProblem
+
+	loc := locales["en"]
+	if config.Locale != "" {
+		if l, ok := locales[localeLanguage(config.Locale)]; ok {
+			loc = l
+		} else {
+			problems = append(problems, newConfigProblem(toml.Key{"format", "locale"},
+				"unsupported locale %q, expected one of en, de, fr, es", config.Locale))
+		}
+	} else if l, ok := locales[localeLanguage(localeFromEnv())]; ok {
+		// Follow the system locale when we have it, English otherwise
+		loc = l
+	}
+
+	clock12 := false
+	switch strings.ToLower(strings.TrimSpace(config.Clock)) {
+	case "":
+	case "12h", "12":
+		clock12 = true
+	case "24h", "24":
+		clock12 = false
+	default:
+		problems = append(problems, newConfigProblem(toml.Key{"format", "clock"},
+			"unknown clock %q, expected \"12h\" or \"24h\"", config.Clock))
+	}
+
+	weekStart := time.Monday
+	if config.WeekStart != "" {
+		if d, ok := parseWeekday(config.WeekStart); ok {
+			weekStart = d
+		} else {
+			problems = append(problems, newConfigProblem(toml.Key{"format", "weekStart"},
+				"unknown weekday %q for weekStart", config.WeekStart))
+		}
+	}
+
+	dates = newDateFormatter(loc, config.formatLayouts, clock12, weekStart)
+	return problems
+}
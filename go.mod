@@ -11,6 +11,7 @@ require (
 	github.com/lrstanley/bubbletint v1.0.0
 	github.com/sahilm/fuzzy v0.1.1
 	github.com/treilik/bubbleboxer v0.2.0
+	go.dalton.dog/bubbleup v1.0.0
 )
 
 require (
@@ -32,7 +33,6 @@ require (
 	github.com/muesli/termenv v0.16.0 // indirect
 	github.com/rivo/uniseg v0.4.7 // indirect
 	github.com/xo/terminfo v0.0.0-20220910002029-abceb7e1c41e // indirect
-	go.dalton.dog/bubbleup v1.0.0 // indirect
 	golang.org/x/sys v0.37.0 // indirect
 	golang.org/x/text v0.3.8 // indirect
 )
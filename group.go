@@ -0,0 +1,147 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+)
+
+// groupMode selects how the List tab splits reminders into sections
+type groupMode int
+
+const (
+	groupNone groupMode = iota
+	groupByList
+	groupByDueDay
+	groupByPriority
+	numGroupModes
+)
+
+var groupModeNames = [numGroupModes]string{
+	groupNone:       "none",
+	groupByList:     "list",
+	groupByDueDay:   "day",
+	groupByPriority: "priority",
+}
+
+func (g groupMode) String() string {
+	if g < 0 || g >= numGroupModes {
+		return "none"
+	}
+	return groupModeNames[g]
+}
+
+func (g groupMode) next() groupMode {
+	return (g + 1) % numGroupModes
+}
+
+// headerItem is a non-selectable section header row in a grouped list
+type headerItem struct {
+	label string
+	count int
+	day   time.Time // the day of a due-day section, zero otherwise
+}
+
+func (h headerItem) FilterValue() string {
+	return ""
+}
+
+func (h headerItem) Title() string {
+	return fmt.Sprintf("%s (%d)", h.label, h.count)
+}
+
+func isHeader(listItem list.Item) bool {
+	_, ok := listItem.(headerItem)
+	return ok
+}
+
+// groupKey returns a sortable key and a display label for an item's section
+func groupKey(it item, mode groupMode, now time.Time) (int, string) {
+	switch mode {
+	case groupByList:
+		// Lists are ordered by label, so the key is the same for all
+		return 0, it.listName
+	case groupByDueDay:
+		if it.parsedDate.IsZero() {
+			return 1 << 30, dates.locale.noDueDate
+		}
+		due := it.parsedDate.In(dueLocation)
+		days := calendarDays(now, due)
+		switch {
+		case days < 0:
+			return -1, dates.locale.overdue
+		case days == 0:
+			return 0, dates.locale.today
+		case days == 1:
+			return 1, dates.locale.tomorrow
+		default:
+			return days, dates.day(due, now.In(dueLocation))
+		}
+	case groupByPriority:
+		switch priorityRank(it.priority) {
+		case 0:
+			return 0, "High priority"
+		case 1:
+			return 1, "Medium priority"
+		case 2:
+			return 2, "Low priority"
+		default:
+			return 3, "No priority"
+		}
+	}
+	return 0, ""
+}
+
+// groupItems splits already-sorted items into sections and inserts a header
+// before each one. Order within a section is preserved.
+func groupItems(items []item, mode groupMode) []list.Item {
+	if mode == groupNone {
+		listItems := make([]list.Item, len(items))
+		for i, it := range items {
+			listItems[i] = it
+		}
+		return listItems
+	}
+
+	type section struct {
+		key   int
+		label string
+		day   time.Time
+		items []item
+	}
+
+	now := currentTime()
+	var sections []*section
+	byLabel := make(map[string]*section)
+	for _, it := range items {
+		key, label := groupKey(it, mode, now)
+		s, ok := byLabel[label]
+		if !ok {
+			s = &section{key: key, label: label}
+			if mode == groupByDueDay && key >= 0 && !it.parsedDate.IsZero() {
+				s.day = it.parsedDate
+			}
+			byLabel[label] = s
+			sections = append(sections, s)
+		}
+		s.items = append(s.items, it)
+	}
+
+	sort.SliceStable(sections, func(i, j int) bool {
+		if sections[i].key != sections[j].key {
+			return sections[i].key < sections[j].key
+		}
+		return sections[i].label < sections[j].label
+	})
+
+	listItems := make([]list.Item, 0, len(items)+len(sections))
+	for _, s := range sections {
+		listItems = append(listItems, headerItem{label: s.label, count: len(s.items), day: s.day})
+		for _, it := range s.items {
+			listItems = append(listItems, it)
+		}
+	}
+	return listItems
+}
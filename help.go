@@ -6,48 +6,43 @@ import (
 	"github.com/charmbracelet/lipgloss"
 )
 
-// Common key bindings shared across views
-type commonKeyMap struct {
-	filter     key.Binding
-	navigate   key.Binding
-	switchTabs key.Binding
-	settings   key.Binding
-	quit       key.Binding
-}
-
-func newCommonKeyMap() commonKeyMap {
-	return commonKeyMap{
-		filter: key.NewBinding(
-			key.WithKeys("/"),
-			key.WithHelp("/", "filter"),
-		),
-		navigate: key.NewBinding(
-			key.WithKeys("h", "j", "k", "l"),
-			key.WithHelp("hjkl", "navigate"),
-		),
-		switchTabs: key.NewBinding(
-			key.WithKeys("tab"),
-			key.WithHelp("tab", "switch tabs"),
-		),
-		settings: key.NewBinding(
-			key.WithKeys("s"),
-			key.WithHelp("s", "settings"),
-		),
-		quit: key.NewBinding(
-			key.WithKeys("q", "ctrl+c"),
-			key.WithHelp("q", "quit"),
-		),
+// commonKeyMap renders the active bindings (from keys.go) in the help view,
+// so rebinding a key in config.toml changes the help too
+type commonKeyMap struct{}
+
+func (commonKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{
+		keys.filter,
+		combinedHelp("sort/reverse", "/", keys.sort, keys.reverseSort),
+		combinedHelp("navigate", "", keys.left, keys.down, keys.up, keys.right),
+		keys.nextTab,
+		keys.settings,
+		keys.quit,
 	}
 }
 
-func (k commonKeyMap) ShortHelp() []key.Binding {
-	return []key.Binding{k.filter, k.navigate, k.switchTabs, k.settings, k.quit}
-}
-
-func (k commonKeyMap) FullHelp() [][]key.Binding {
+func (commonKeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
-		{k.filter, k.navigate, k.switchTabs},
-		{k.settings, k.quit},
+		{
+			keys.filter,
+			keys.rank,
+			combinedHelp("sort/reverse", "/", keys.sort, keys.reverseSort),
+			keys.group,
+			keys.density,
+			combinedHelp("navigate", "", keys.left, keys.down, keys.up, keys.right),
+			combinedHelp("page down/up", "/", keys.nextPage, keys.prevPage),
+			combinedHelp("start/end", "/", keys.goToStart, keys.goToEnd),
+			keys.nextTab,
+		},
+		{
+			keys.zoom,
+			combinedHelp("move/pin/collapse column", "/", keys.moveColumnLeft, keys.moveColumnRight, keys.pin, keys.collapse),
+			combinedHelp("next/prev theme", "/", keys.nextTheme, keys.prevTheme),
+			keys.forecast,
+			keys.summary,
+			keys.settings,
+			keys.quit,
+		},
 	}
 }
 
@@ -60,32 +55,37 @@ type commonHelp struct {
 func newCommonHelp() commonHelp {
 	h := help.New()
 
+	ch := commonHelp{
+		help: h,
+		keys: commonKeyMap{},
+	}
+	ch.applyTheme()
+	return ch
+}
+
+// applyTheme restyles the help view with the current theme
+func (h *commonHelp) applyTheme() {
 	// Customize the help view styles with theme
-	h.Styles.ShortKey = lipgloss.NewStyle().
+	h.help.Styles.ShortKey = lipgloss.NewStyle().
 		Foreground(theme.BrightBlack())
 
-	h.Styles.ShortDesc = lipgloss.NewStyle().
+	h.help.Styles.ShortDesc = lipgloss.NewStyle().
 		Foreground(theme.Fg())
 
-	h.Styles.ShortSeparator = lipgloss.NewStyle().
+	h.help.Styles.ShortSeparator = lipgloss.NewStyle().
 		Foreground(theme.BrightBlack())
 
-	h.Styles.FullKey = lipgloss.NewStyle().
+	h.help.Styles.FullKey = lipgloss.NewStyle().
 		Foreground(theme.BrightCyan())
 
-	h.Styles.FullDesc = lipgloss.NewStyle().
+	h.help.Styles.FullDesc = lipgloss.NewStyle().
 		Foreground(theme.Fg())
 
-	h.Styles.FullSeparator = lipgloss.NewStyle().
+	h.help.Styles.FullSeparator = lipgloss.NewStyle().
 		Foreground(theme.BrightBlack())
 
-	h.Styles.Ellipsis = lipgloss.NewStyle().
+	h.help.Styles.Ellipsis = lipgloss.NewStyle().
 		Foreground(theme.BrightBlack())
-
-	return commonHelp{
-		help: h,
-		keys: newCommonKeyMap(),
-	}
 }
 
 func (h commonHelp) View(width int) string {
@@ -0,0 +1,357 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/BurntSushi/toml"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+)
+
+// keyMap holds every rebindable action. Keys come from the [keys] table in
+// config.toml, where each action takes a key or a list of keys:
+//
+//	[keys]
+//	quit = "x"
+//	down = ["j", "down", "ctrl+n"]
+//
+// ctrl+c always quits and can't be rebound. Actions used while typing a
+// filter or editing a reminder (confirm, cancel, rank, nextField, prevField)
+// can't take a key that types a character.
+type keyMap struct {
+	// Global
+	quit      key.Binding
+	settings  key.Binding
+	nextTab   key.Binding
+	prevTab   key.Binding
+	edit      key.Binding
+	nextTheme key.Binding
+	prevTheme key.Binding
+	forecast  key.Binding
+	summary   key.Binding
+
+	// List and column views
+	filter      key.Binding
+	rank        key.Binding
+	sort        key.Binding
+	reverseSort key.Binding
+	group       key.Binding
+	density     key.Binding
+	zoom        key.Binding
+	up          key.Binding
+	down        key.Binding
+	left        key.Binding
+	right       key.Binding
+	nextSection key.Binding
+	prevSection key.Binding
+	nextPage    key.Binding
+	prevPage    key.Binding
+	goToStart   key.Binding
+	goToEnd     key.Binding
+
+	// Column arrangement
+	moveColumnLeft  key.Binding
+	moveColumnRight key.Binding
+	pin             key.Binding
+	collapse        key.Binding
+
+	// Filter input, edit overlay and list picker
+	confirm    key.Binding
+	cancel     key.Binding
+	nextField  key.Binding
+	prevField  key.Binding
+	toggle     key.Binding
+	enableAll  key.Binding
+	disableAll key.Binding
+}
+
+// Active key bindings (set by loadConfig in reminders.go)
+var keys = defaultKeyMap()
+
+func newBinding(desc string, keys ...string) key.Binding {
+	names := make([]string, len(keys))
+	for i, k := range keys {
+		names[i] = keyName(k)
+	}
+	return key.NewBinding(
+		key.WithKeys(keys...),
+		key.WithHelp(strings.Join(names, "/"), desc),
+	)
+}
+
+// keyName is how a key is shown in help
+func keyName(k string) string {
+	if k == " " {
+		return "space"
+	}
+	return k
+}
+
+func defaultKeyMap() keyMap {
+	return keyMap{
+		quit:      newBinding("quit", "q"),
+		settings:  newBinding("settings", "s"),
+		nextTab:   newBinding("switch tabs", "tab"),
+		prevTab:   newBinding("previous tab", "shift+tab"),
+		edit:      newBinding("edit", "enter"),
+		nextTheme: newBinding("next theme", "t"),
+		prevTheme: newBinding("previous theme", "T"),
+		forecast:  newBinding("forecast", "w"),
+		summary:   newBinding("next due", "n"),
+
+		filter:      newBinding("filter", "/"),
+		rank:        newBinding("rank by date/relevance", "ctrl+r"),
+		sort:        newBinding("sort", "o"),
+		reverseSort: newBinding("reverse sort", "O"),
+		group:       newBinding("group", "v"),
+		density:     newBinding("compact", "D"),
+		zoom:        newBinding("zoom column", "z"),
+		up:          newBinding("up", "k", "up"),
+		down:        newBinding("down", "j", "down"),
+		left:        newBinding("left", "h", "left"),
+		right:       newBinding("right", "l", "right"),
+		nextSection: newBinding("next section", "ctrl+d"),
+		prevSection: newBinding("previous section", "ctrl+u"),
+		nextPage:    newBinding("next page", "pgdown", "f", "d"),
+		prevPage:    newBinding("previous page", "pgup", "b", "u"),
+		goToStart:   newBinding("go to start", "home", "g"),
+		goToEnd:     newBinding("go to end", "end", "G"),
+
+		moveColumnLeft:  newBinding("move column left", "H"),
+		moveColumnRight: newBinding("move column right", "L"),
+		pin:             newBinding("pin column", "p"),
+		collapse:        newBinding("collapse column", "c"),
+
+		confirm:    newBinding("confirm", "enter"),
+		cancel:     newBinding("cancel", "esc"),
+		nextField:  newBinding("next field", "tab"),
+		prevField:  newBinding("previous field", "shift+tab"),
+		toggle:     newBinding("toggle", " "),
+		enableAll:  newBinding("enable all", "a"),
+		disableAll: newBinding("disable all", "n"),
+	}
+}
+
+// Where an action is active. Two actions may only share a key when they are
+// never active at the same time.
+type keyContext int
+
+const (
+	ctxViews     keyContext = 1 << iota // browsing the list or columns
+	ctxFiltering                        // typing a filter
+	ctxPicker                           // settings overlay
+	ctxEdit                             // edit overlay
+)
+
+type keyAction struct {
+	name     string // key in the [keys] table
+	binding  *key.Binding
+	contexts keyContext
+}
+
+// interceptsTyping reports whether a is matched before keystrokes reach the
+// filter or edit text inputs. The edit overlay's toggle only applies on its
+// checkboxes, where nothing is typed.
+func (a keyAction) interceptsTyping() bool {
+	return a.contexts&ctxFiltering != 0 || (a.contexts&ctxEdit != 0 && a.name != "toggle")
+}
+
+// printableKeys picks out the keys in list that type a character, like "a"
+// or " ", rather than naming a special key like "enter" or "ctrl+r"
+func printableKeys(list keyList) []string {
+	var typed []string
+	for _, k := range list {
+		if r := []rune(k); len(r) == 1 && unicode.IsPrint(r[0]) {
+			typed = append(typed, k)
+		}
+	}
+	return typed
+}
+
+// actions lists every action in k by its config name
+func (k *keyMap) actions() []keyAction {
+	return []keyAction{
+		{"quit", &k.quit, ctxViews | ctxPicker},
+		{"settings", &k.settings, ctxViews | ctxPicker},
+		{"nextTab", &k.nextTab, ctxViews},
+		{"prevTab", &k.prevTab, ctxViews},
+		{"edit", &k.edit, ctxViews},
+		{"nextTheme", &k.nextTheme, ctxViews},
+		{"prevTheme", &k.prevTheme, ctxViews},
+		{"forecast", &k.forecast, ctxViews},
+		{"summary", &k.summary, ctxViews},
+		{"filter", &k.filter, ctxViews},
+		{"rank", &k.rank, ctxViews | ctxFiltering},
+		{"sort", &k.sort, ctxViews},
+		{"reverseSort", &k.reverseSort, ctxViews},
+		{"group", &k.group, ctxViews},
+		{"density", &k.density, ctxViews},
+		{"zoom", &k.zoom, ctxViews},
+		{"up", &k.up, ctxViews | ctxPicker},
+		{"down", &k.down, ctxViews | ctxPicker},
+		{"left", &k.left, ctxViews},
+		{"right", &k.right, ctxViews},
+		{"nextSection", &k.nextSection, ctxViews},
+		{"prevSection", &k.prevSection, ctxViews},
+		{"nextPage", &k.nextPage, ctxViews},
+		{"prevPage", &k.prevPage, ctxViews},
+		{"goToStart", &k.goToStart, ctxViews},
+		{"goToEnd", &k.goToEnd, ctxViews},
+		{"moveColumnLeft", &k.moveColumnLeft, ctxViews},
+		{"moveColumnRight", &k.moveColumnRight, ctxViews},
+		{"pin", &k.pin, ctxViews},
+		{"collapse", &k.collapse, ctxViews},
+		{"confirm", &k.confirm, ctxFiltering | ctxPicker | ctxEdit},
+		{"cancel", &k.cancel, ctxViews | ctxFiltering | ctxPicker | ctxEdit},
+		{"nextField", &k.nextField, ctxEdit},
+		{"prevField", &k.prevField, ctxEdit},
+		{"toggle", &k.toggle, ctxPicker | ctxEdit},
+		{"enableAll", &k.enableAll, ctxPicker},
+		{"disableAll", &k.disableAll, ctxPicker},
+	}
+}
+
+// keyList is one or more keys for an action; config accepts a string or an array
+type keyList []string
+
+func (l *keyList) UnmarshalTOML(data interface{}) error {
+	switch v := data.(type) {
+	case string:
+		*l = keyList{v}
+	case []interface{}:
+		for _, k := range v {
+			s, ok := k.(string)
+			if !ok {
+				return fmt.Errorf("keys must be strings, got %v", k)
+			}
+			*l = append(*l, s)
+		}
+	default:
+		return fmt.Errorf("keys must be a string or an array of strings, got %v", data)
+	}
+	return nil
+}
+
+// buildKeyMap applies the [keys] table over the defaults. Unknown actions are
+// skipped, and overrides that conflict with another action are dropped so the
+// action keeps its default keys. Reports both.
+func buildKeyMap(overrides map[string]keyList) (keyMap, []configProblem) {
+	var problems []configProblem
+	k := defaultKeyMap()
+	byName := make(map[string]keyAction)
+	for _, a := range k.actions() {
+		byName[strings.ToLower(a.name)] = a
+	}
+
+	overridden := make(map[string]bool)
+	configName := make(map[string]string) // action name to the name used in config
+	for name, list := range overrides {
+		a, ok := byName[strings.ToLower(name)]
+		if !ok {
+			problems = append(problems, newConfigProblem(toml.Key{"keys", name}, "unknown key action %q", name))
+			continue
+		}
+		if len(list) == 0 {
+			problems = append(problems, newConfigProblem(toml.Key{"keys", name}, "no keys for action %q", name))
+			continue
+		}
+		if typed := printableKeys(list); len(typed) > 0 && a.interceptsTyping() {
+			problems = append(problems, newConfigProblem(toml.Key{"keys", name},
+				"key %q for %q would stop it being typed into the filter or edit fields, keeping the default", typed[0], name))
+			continue
+		}
+		*a.binding = newBinding(a.binding.Help().Desc, list...)
+		overridden[a.name] = true
+		configName[a.name] = name
+	}
+
+	// Revert overrides involved in a conflict until none are left. Defaults
+	// never conflict with each other, so this always ends.
+	for {
+		conflicts := k.conflicts()
+		reverted := false
+		defaults := defaultKeyMap()
+		defaultActions := defaults.actions()
+		for i, a := range k.actions() {
+			for _, other := range conflicts[a.name] {
+				if overridden[a.name] {
+					problems = append(problems, newConfigProblem(toml.Key{"keys", configName[a.name]},
+						"key %q is bound to both %q and %q, keeping the default for %q", other.key, a.name, other.action, a.name))
+				}
+			}
+			if len(conflicts[a.name]) > 0 && overridden[a.name] {
+				*a.binding = *defaultActions[i].binding
+				overridden[a.name] = false
+				reverted = true
+			}
+		}
+		if !reverted {
+			break
+		}
+	}
+
+	return k, problems
+}
+
+type keyConflict struct {
+	key    string
+	action string
+}
+
+// conflicts maps each action to the other actions sharing a key with it in
+// a context where both are active
+func (k *keyMap) conflicts() map[string][]keyConflict {
+	conflicts := make(map[string][]keyConflict)
+	actions := k.actions()
+	for i, a := range actions {
+		for _, b := range actions[i+1:] {
+			if a.contexts&b.contexts == 0 {
+				continue
+			}
+			for _, ka := range a.binding.Keys() {
+				for _, kb := range b.binding.Keys() {
+					if ka == kb {
+						conflicts[a.name] = append(conflicts[a.name], keyConflict{ka, b.name})
+						conflicts[b.name] = append(conflicts[b.name], keyConflict{ka, a.name})
+					}
+				}
+			}
+		}
+	}
+	return conflicts
+}
+
+// applyListKeys points a bubbles list's keys at the active bindings. Left and
+// right page as well, since a single list has nothing else to move between
+// (columns catch them before their lists do). Quitting, filtering and help are
+// handled by our own models, so the list's versions are off.
+func applyListKeys(km *list.KeyMap) {
+	km.CursorUp = keys.up
+	km.CursorDown = keys.down
+	km.NextPage = key.NewBinding(key.WithKeys(slices.Concat(keys.right.Keys(), keys.nextPage.Keys())...))
+	km.PrevPage = key.NewBinding(key.WithKeys(slices.Concat(keys.left.Keys(), keys.prevPage.Keys())...))
+	km.GoToStart = keys.goToStart
+	km.GoToEnd = keys.goToEnd
+	km.ShowFullHelp.SetEnabled(false)
+	km.CloseFullHelp.SetEnabled(false)
+	km.Quit.SetEnabled(false)
+	km.ForceQuit.SetEnabled(false)
+	km.Filter.SetEnabled(false)
+}
+
+// combinedHelp makes a help entry covering several actions, e.g. "o/O" for
+// sort and reverse. It shows the first key of each action, joined by sep.
+func combinedHelp(desc, sep string, bindings ...key.Binding) key.Binding {
+	var firsts, all []string
+	for _, b := range bindings {
+		if len(b.Keys()) == 0 {
+			continue
+		}
+		firsts = append(firsts, keyName(b.Keys()[0]))
+		all = append(all, b.Keys()...)
+	}
+	return key.NewBinding(key.WithKeys(all...), key.WithHelp(strings.Join(firsts, sep), desc))
+}
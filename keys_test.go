@@ -0,0 +1,47 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBuildKeyMap(t *testing.T) {
+	tests := []struct {
+		name        string
+		overrides   map[string]keyList
+		action      string
+		wantKeys    []string
+		wantProblem string // part of the only problem, empty for none
+	}{
+		{"rebinds an action", map[string]keyList{"quit": {"x"}}, "quit", []string{"x"}, ""},
+		{"case-insensitive action name", map[string]keyList{"NextField": {"ctrl+n"}}, "nextField", []string{"ctrl+n"}, ""},
+		{"named keys while typing", map[string]keyList{"confirm": {"ctrl+s", "enter"}}, "confirm", []string{"ctrl+s", "enter"}, ""},
+		{"printable confirm", map[string]keyList{"confirm": {"a"}}, "confirm", []string{"enter"}, `key "a" for "confirm" would stop it being typed`},
+		{"printable cancel among others", map[string]keyList{"cancel": {"esc", "q"}}, "cancel", []string{"esc"}, `key "q" for "cancel"`},
+		{"space for the next field", map[string]keyList{"nextField": {" "}}, "nextField", []string{"tab"}, `key " " for "nextField"`},
+		{"printable rank", map[string]keyList{"rank": {"é"}}, "rank", []string{"ctrl+r"}, `key "é" for "rank"`},
+		{"printable toggle for checkboxes", map[string]keyList{"toggle": {"x"}}, "toggle", []string{"x"}, ""},
+		{"unknown action", map[string]keyList{"fly": {"f"}}, "quit", []string{"q"}, `unknown key action "fly"`},
+		{"conflict keeps the default", map[string]keyList{"sort": {"z"}}, "sort", []string{"o"}, `bound to both "sort" and "zoom"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, problems := buildKeyMap(tt.overrides)
+			var binding keyAction
+			for _, a := range k.actions() {
+				if a.name == tt.action {
+					binding = a
+				}
+			}
+			if got := binding.binding.Keys(); strings.Join(got, ",") != strings.Join(tt.wantKeys, ",") {
+				t.Errorf("%s keys = %q, want %q", tt.action, got, tt.wantKeys)
+			}
+			switch {
+			case tt.wantProblem == "" && len(problems) > 0:
+				t.Errorf("problems = %v, want none", problems)
+			case tt.wantProblem != "" && (len(problems) != 1 || !strings.Contains(problems[0].msg, tt.wantProblem)):
+				t.Errorf("problems = %v, want one containing %q", problems, tt.wantProblem)
+			}
+		})
+	}
+}
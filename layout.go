@@ -0,0 +1,122 @@
+package main
+
+import "strings"
+
+// Space each column adds around its content: space + bar + space
+const columnChromeWidth = 3
+
+// Content width of a collapsed column, enough for one title character with padding
+const collapsedColumnWidth = 3
+
+// columnLayout is the policy for sizing columns in the Columns tab, loaded
+// from the [columns] table in config.toml:
+//
+//	[columns]
+//	layout = "fill"   # "fixed" or "fill"
+//	width = 50        # width of each column in fixed mode
+//	minWidth = 40     # fill mode: columns never shrink below this
+//	maxWidth = 80     # fill mode: columns never grow above this
+//
+//	[columns.widths]  # per-list overrides, used as-is in both modes
+//	Work = 70
+type columnLayout struct {
+	Mode     string         `toml:"layout"`
+	Width    int            `toml:"width"`
+	MinWidth int            `toml:"minWidth"`
+	MaxWidth int            `toml:"maxWidth"`
+	Widths   map[string]int `toml:"widths"`
+}
+
+func defaultColumnLayout() columnLayout {
+	return columnLayout{
+		Mode:     "fixed",
+		Width:    50,
+		MinWidth: 40,
+		MaxWidth: 80,
+	}
+}
+
+// layoutConfig is the active column layout (set by loadConfig in reminders.go)
+var layoutConfig = defaultColumnLayout()
+
+// normalized fills in missing or inconsistent values and lowercases the
+// per-list override keys for case-insensitive lookup
+func (l columnLayout) normalized() columnLayout {
+	def := defaultColumnLayout()
+	l.Mode = strings.ToLower(l.Mode)
+	if l.Mode != "fill" {
+		l.Mode = "fixed"
+	}
+	if l.Width <= 0 {
+		l.Width = def.Width
+	}
+	if l.MinWidth <= 0 {
+		l.MinWidth = def.MinWidth
+	}
+	if l.MaxWidth < l.MinWidth {
+		l.MaxWidth = l.MinWidth
+	}
+	widths := make(map[string]int, len(l.Widths))
+	for name, w := range l.Widths {
+		if w > 0 {
+			widths[strings.ToLower(name)] = w
+		}
+	}
+	l.Widths = widths
+	return l
+}
+
+// baseWidth is the content width a column starts from before filling, and
+// whether that width is fixed
+func (l columnLayout) baseWidth(listName string, collapsed bool) (int, bool) {
+	if collapsed {
+		return collapsedColumnWidth, true
+	}
+	if w, ok := l.Widths[strings.ToLower(listName)]; ok {
+		return w, true
+	}
+	if l.Mode == "fill" {
+		return l.MinWidth, false
+	}
+	return l.Width, false
+}
+
+// columnWidths returns the content widths of the columns that fit in
+// available, starting at start. At least one column is always returned (when
+// any exist), shrunk to fit if the terminal is narrower than it.
+func (l columnLayout) columnWidths(listNames []string, collapsed map[string]bool, start, available int) []int {
+	if start < 0 || start >= len(listNames) {
+		return nil
+	}
+
+	var widths []int
+	var flexible []int // indexes into widths that fill mode may grow
+	used := 0
+	for _, name := range listNames[start:] {
+		w, fixed := l.baseWidth(name, collapsed[name])
+		if len(widths) > 0 && used+w+columnChromeWidth > available {
+			break
+		}
+		if !fixed {
+			flexible = append(flexible, len(widths))
+		}
+		widths = append(widths, w)
+		used += w + columnChromeWidth
+	}
+
+	// A single column wider than the terminal is shrunk to fit
+	if used > available {
+		widths[0] = max(available-columnChromeWidth, 10)
+		return widths
+	}
+
+	// Share the leftover space among columns without an override
+	if l.Mode == "fill" && len(flexible) > 0 {
+		extra := (available - used) / len(flexible)
+		for _, i := range flexible {
+			widths[i] = min(widths[i]+extra, l.MaxWidth)
+		}
+	}
+
+	return widths
+}
@@ -1,20 +1,16 @@
 package main
 
 import (
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
-	tint "github.com/lrstanley/bubbletint"
-	"github.com/sahilm/fuzzy"
-	"sort"
+	"strings"
 	"time"
 )
 
 var (
-	// Theme for the app
-	theme = tint.TintRosePine
-
 	appStyle = lipgloss.NewStyle().Padding(1, 2)
 
 	titleStyle = lipgloss.NewStyle().
@@ -31,6 +27,10 @@ type item struct {
 	urgencyText  string
 	urgencyColor string
 	parsedDate   time.Time
+	allDay       bool
+	startDate    time.Time
+	priority     int
+	notes        string
 	externalID   string
 	completed    bool
 }
@@ -55,19 +55,10 @@ func (i item) FilterValue() string {
 	return i.title
 }
 
-// Helper to convert urgency color names to theme colors
+// Helper to convert urgency colors from config to terminal colors
 func urgencyColorToTheme(colorName string) lipgloss.TerminalColor {
-	switch colorName {
-	case "red":
-		return theme.Red()
-	case "orange":
-		return theme.Yellow()
-	case "yellow":
-		return theme.White()
-	default:
-		// Use subtle/dimmed color for non-urgent items
-		return theme.BrightBlack()
-	}
+	// Use subtle/dimmed color for non-urgent items
+	return colorOr(colorName, theme.BrightBlack())
 }
 
 type listKeyMap struct{}
@@ -78,6 +69,7 @@ func newListKeyMap() *listKeyMap {
 
 type listModel struct {
 	list         list.Model
+	delegate     customItemDelegate
 	delegateKeys *delegateKeyMap
 	commonHelp   commonHelp
 	width        int
@@ -88,9 +80,14 @@ type listModel struct {
 	filterValue string
 	filtering   bool
 	filterInput textinput.Model
+	prevFilter  string // filter in effect before "/" was pressed, restored on esc
+	filterTag   int    // incremented on each keystroke to debounce live filtering
+	rank        searchRank
+	order       sortOrder
+	groupBy     groupMode
 
 	// Status line
-	status string
+	status statusLine
 }
 
 func newListModel() listModel {
@@ -111,84 +108,107 @@ func newListModel() listModel {
 	ti.Prompt = "/"
 	ti.CharLimit = 100
 	ti.Width = 1000 // Prevent wrapping
-	ti.PromptStyle = lipgloss.NewStyle().Foreground(theme.BrightCyan())
-	ti.TextStyle = lipgloss.NewStyle().Foreground(theme.Fg())
-	ti.PlaceholderStyle = lipgloss.NewStyle().Foreground(theme.BrightBlack())
 
 	// Setup list
 	delegate := newItemDelegate(delegateKeys)
+	delegate.compact = strings.EqualFold(densityDefaults.List, "compact")
 	remindersList := list.New(items, delegate, 0, 0)
 	remindersList.Title = ""
-	remindersList.Styles.Title = titleStyle
 	remindersList.SetShowTitle(false)
 
+	// Keep filtering enabled but filter UI will be hidden
+	remindersList.SetShowFilter(false)
+	remindersList.SetFilteringEnabled(true)
+
+	remindersList.SetShowPagination(true)
+	remindersList.SetShowHelp(false) // Disable list's built-in help, we use commonHelp
+	applyListKeys(&remindersList.KeyMap)
+
+	m := listModel{
+		list:         remindersList,
+		delegate:     delegate,
+		delegateKeys: delegateKeys,
+		commonHelp:   newCommonHelp(),
+		allItems:     items,
+		filterInput:  ti,
+		filtering:    false,
+		filterValue:  "",
+	}
+	m.applyTheme()
+	return m
+}
+
+// applyTheme restyles the list, delegate, filter input and help with the
+// current theme
+func (m *listModel) applyTheme() {
+	m.list.Styles.Title = titleStyle
+
 	// Customize list styles with theme colors
-	remindersList.Styles.PaginationStyle = lipgloss.NewStyle().
+	m.list.Styles.PaginationStyle = lipgloss.NewStyle().
 		Foreground(theme.BrightBlack())
 
-	remindersList.Styles.HelpStyle = lipgloss.NewStyle().
+	m.list.Styles.HelpStyle = lipgloss.NewStyle().
 		Foreground(theme.BrightBlack())
 
-	remindersList.Styles.ActivePaginationDot = lipgloss.NewStyle().
+	m.list.Styles.ActivePaginationDot = lipgloss.NewStyle().
 		Foreground(theme.BrightCyan()).
 		SetString("•")
 
-	remindersList.Styles.InactivePaginationDot = lipgloss.NewStyle().
+	m.list.Styles.InactivePaginationDot = lipgloss.NewStyle().
 		Foreground(theme.BrightBlack()).
 		SetString("•")
 
 	// Make filter UI use empty strings - we show it at bottom instead
-	remindersList.Styles.FilterPrompt = lipgloss.NewStyle()
-	remindersList.Styles.FilterCursor = lipgloss.NewStyle()
+	m.list.Styles.FilterPrompt = lipgloss.NewStyle()
+	m.list.Styles.FilterCursor = lipgloss.NewStyle()
 
 	// Remove "No items" text when list is empty
-	remindersList.Styles.NoItems = lipgloss.NewStyle()
-
-	// Keep filtering enabled but filter UI will be hidden
-	remindersList.SetShowFilter(false)
-	remindersList.SetFilteringEnabled(true)
+	m.list.Styles.NoItems = lipgloss.NewStyle()
 
 	// Customize the help view styles
-	remindersList.Help.Styles.ShortKey = lipgloss.NewStyle().
+	m.list.Help.Styles.ShortKey = lipgloss.NewStyle().
 		Foreground(theme.BrightBlack())
 
-	remindersList.Help.Styles.ShortDesc = lipgloss.NewStyle().
+	m.list.Help.Styles.ShortDesc = lipgloss.NewStyle().
 		Foreground(theme.Fg())
 
-	remindersList.Help.Styles.ShortSeparator = lipgloss.NewStyle().
+	m.list.Help.Styles.ShortSeparator = lipgloss.NewStyle().
 		Foreground(theme.BrightBlack())
 
-	remindersList.Help.Styles.FullKey = lipgloss.NewStyle().
+	m.list.Help.Styles.FullKey = lipgloss.NewStyle().
 		Foreground(theme.BrightCyan())
 
-	remindersList.Help.Styles.FullDesc = lipgloss.NewStyle().
+	m.list.Help.Styles.FullDesc = lipgloss.NewStyle().
 		Foreground(theme.Fg())
 
-	remindersList.Help.Styles.FullSeparator = lipgloss.NewStyle().
+	m.list.Help.Styles.FullSeparator = lipgloss.NewStyle().
 		Foreground(theme.BrightBlack())
 
-	remindersList.Help.Styles.Ellipsis = lipgloss.NewStyle().
+	m.list.Help.Styles.Ellipsis = lipgloss.NewStyle().
 		Foreground(theme.BrightBlack())
 
-	remindersList.SetShowPagination(true)
-	remindersList.SetShowHelp(false) // Disable list's built-in help, we use commonHelp
-
-	return listModel{
-		list:         remindersList,
-		delegateKeys: delegateKeys,
-		commonHelp:   newCommonHelp(),
-		allItems:     items,
-		filterInput:  ti,
-		filtering:    false,
-		filterValue:  "",
-		status:       "",
-	}
+	styleFilterInput(&m.filterInput)
+	m.commonHelp.applyTheme()
+	m.delegate.applyTheme()
+	m.list.SetDelegate(m.delegate)
 }
 
 func (m listModel) Init() tea.Cmd {
 	return nil
 }
 
+// selectID moves the cursor to the reminder with the given external ID,
+// reporting whether it was found
+func (m *listModel) selectID(id string) bool {
+	for i, it := range m.list.Items() {
+		if it, ok := it.(item); ok && it.externalID == id {
+			m.list.Select(i)
+			return true
+		}
+	}
+	return false
+}
+
 func (m *listModel) reloadWithFilter(enabledLists []string) tea.Cmd {
 	items, err := loadRemindersFiltered(enabledLists)
 	if err != nil {
@@ -196,9 +216,29 @@ func (m *listModel) reloadWithFilter(enabledLists []string) tea.Cmd {
 		return nil
 	}
 
-	// SetItems returns a command that needs to be executed
-	// This command handles refiltering if a filter is active
-	return m.list.SetItems(items)
+	// Re-apply the current filter and sort order to the new items
+	m.allItems = items
+	m.applyFilter(m.filterValue)
+	return nil
+}
+
+// refreshTimes redoes the due labels and countdowns in the list as of now,
+// then reapplies the filter and sort and puts the cursor back on the same
+// reminder
+func (m *listModel) refreshTimes(now time.Time) {
+	selected := ""
+	if it, ok := m.list.SelectedItem().(item); ok {
+		selected = it.externalID
+	}
+	for i, listItem := range m.allItems {
+		if it, ok := listItem.(item); ok {
+			m.allItems[i] = it.withTimes(now)
+		}
+	}
+	m.applyFilter(m.filterValue)
+	if selected != "" {
+		m.selectID(selected)
+	}
 }
 
 func (m listModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -208,17 +248,37 @@ func (m listModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
+		// Resizing changes items per page, so reselect to keep the same item
+		index := m.list.Index()
 		m.list.SetSize(msg.Width, msg.Height)
+		if index < len(m.list.Items()) {
+			m.list.Select(index)
+		}
+
+	case filterDebounceMsg:
+		// Ignore stale ticks from earlier keystrokes
+		if m.filtering && msg.tag == m.filterTag {
+			m.applyFilter(m.filterInput.Value())
+		}
+		return m, nil
 
 	case tea.KeyMsg:
 		// Handle custom filtering
 		if m.filtering {
-			switch msg.String() {
-			case "esc":
+			switch {
+			case key.Matches(msg, keys.rank):
+				// Switch ranking and re-run the filter with what's typed so far
+				m.rank = m.rank.toggle()
+				m.applyFilter(m.filterInput.Value())
+				return m, nil
+			case key.Matches(msg, keys.cancel):
+				// Restore the filter that was active before we started typing
 				m.filtering = false
 				m.filterInput.Blur()
+				m.filterInput.SetValue(m.prevFilter)
+				m.applyFilter(m.prevFilter)
 				return m, nil
-			case "enter":
+			case key.Matches(msg, keys.confirm):
 				m.filtering = false
 				m.filterInput.Blur()
 				m.applyFilter(m.filterInput.Value())
@@ -227,19 +287,70 @@ func (m listModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				newFilterInput, cmd := m.filterInput.Update(msg)
 				m.filterInput = newFilterInput
 				cmds = append(cmds, cmd)
+				// Re-run the filter once typing pauses
+				if m.filterInput.Value() != m.filterValue {
+					m.filterTag++
+					cmds = append(cmds, debounceFilter(m.filterTag))
+				}
 				return m, tea.Batch(cmds...)
 			}
 		}
 
-		// Handle "/" to start filtering
-		if msg.String() == "/" {
+		// Start filtering
+		if key.Matches(msg, keys.filter) {
 			m.filtering = true
+			m.prevFilter = m.filterValue
 			m.filterInput.Focus()
 			return m, nil
 		}
 
-		// Handle "esc" to clear filter
-		if msg.String() == "esc" && m.filterValue != "" {
+		// Switch ranking of an applied filter
+		if key.Matches(msg, keys.rank) && m.filterValue != "" {
+			m.rank = m.rank.toggle()
+			m.applyFilter(m.filterValue)
+			return m, nil
+		}
+
+		// Cycle sort mode and reverse direction
+		switch {
+		case key.Matches(msg, keys.sort):
+			m.order = m.order.next()
+			m.applyFilter(m.filterValue)
+			return m, nil
+		case key.Matches(msg, keys.reverseSort):
+			m.order = m.order.flipped()
+			m.applyFilter(m.filterValue)
+			return m, nil
+		}
+
+		// Toggle compact density
+		if key.Matches(msg, keys.density) {
+			m.delegate.compact = !m.delegate.compact
+			m.list.SetDelegate(m.delegate)
+			return m, nil
+		}
+
+		// Cycle section grouping
+		if key.Matches(msg, keys.group) {
+			m.groupBy = m.groupBy.next()
+			m.applyFilter(m.filterValue)
+			return m, nil
+		}
+
+		// Page and half-page jumps land on the next or previous section when grouped
+		if m.groupBy != groupNone {
+			if key.Matches(msg, m.list.KeyMap.NextPage, keys.nextSection) {
+				m.jumpGroup(true)
+				return m, nil
+			}
+			if key.Matches(msg, m.list.KeyMap.PrevPage, keys.prevSection) {
+				m.jumpGroup(false)
+				return m, nil
+			}
+		}
+
+		// Clear the filter
+		if key.Matches(msg, keys.cancel) && m.filterValue != "" {
 			m.filterInput.SetValue("")
 			m.filterValue = ""
 			m.applyFilter("")
@@ -254,57 +365,142 @@ func (m listModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	}
 
 	// This will also call our delegate's update function.
+	prevIndex := m.list.Index()
 	newListModel, cmd := m.list.Update(msg)
 	m.list = newListModel
 	cmds = append(cmds, cmd)
 
+	// Keep the cursor off section headers, continuing in the direction it moved
+	if _, ok := msg.(tea.KeyMsg); ok {
+		m.skipHeaders(m.list.Index() >= prevIndex)
+	}
+
 	return m, tea.Batch(cmds...)
 }
 
-func (m *listModel) applyFilter(query string) {
-	m.filterValue = query
+// handleMouse scrolls on wheel events and selects the clicked reminder. It
+// reports whether a reminder was clicked.
+func (m *listModel) handleMouse(msg tea.MouseMsg) bool {
+	if dir := wheelDirection(msg); dir != 0 {
+		if dir < 0 {
+			m.list.CursorUp()
+		} else {
+			m.list.CursorDown()
+		}
+		m.skipHeaders(dir > 0)
+		return false
+	}
 
-	var filteredItems []list.Item
-	if query == "" {
-		filteredItems = m.allItems
-	} else {
-		// Fuzzy search across all items
-		var searchStrings []string
-		for _, listItem := range m.allItems {
-			if it, ok := listItem.(item); ok {
-				searchStrings = append(searchStrings, it.title)
+	if !isLeftClick(msg) || msg.X < viewPaddingLeft {
+		return false
+	}
+	index, ok := listItemAt(m.list, m.delegate, msg.Y-viewPaddingTop)
+	if !ok || isHeader(m.list.VisibleItems()[index]) {
+		return false
+	}
+	m.list.Select(index)
+	return true
+}
+
+// skipHeaders moves the cursor off a header row, trying the given direction
+// first and the opposite one if there are no items that way
+func (m *listModel) skipHeaders(forward bool) {
+	items := m.list.Items()
+	idx := m.list.Index()
+	if idx < 0 || idx >= len(items) || !isHeader(items[idx]) {
+		return
+	}
+
+	step := 1
+	if !forward {
+		step = -1
+	}
+	for _, dir := range []int{step, -step} {
+		for i := idx + dir; i >= 0 && i < len(items); i += dir {
+			if !isHeader(items[i]) {
+				m.list.Select(i)
+				return
 			}
 		}
+	}
+}
 
-		matches := fuzzy.Find(query, searchStrings)
-
-		filteredItems = make([]list.Item, len(matches))
-		for i, match := range matches {
-			filteredItems[i] = m.allItems[match.Index]
+// jumpGroup selects the first item of the next or previous section
+func (m *listModel) jumpGroup(forward bool) {
+	items := m.list.Items()
+	idx := m.list.Index()
+
+	if forward {
+		for i := idx + 1; i < len(items); i++ {
+			if isHeader(items[i]) {
+				m.list.Select(i)
+				m.skipHeaders(true)
+				return
+			}
 		}
+		return
 	}
 
-	// Sort filtered items by due date
-	sort.Slice(filteredItems, func(i, j int) bool {
-		it1, ok1 := filteredItems[i].(item)
-		it2, ok2 := filteredItems[j].(item)
-		if !ok1 || !ok2 {
-			return false
+	// Find the current section's header, then the one before it
+	current := -1
+	for i := idx; i >= 0; i-- {
+		if isHeader(items[i]) {
+			current = i
+			break
 		}
-		// Items without due dates go to the end
-		if it1.parsedDate.IsZero() && !it2.parsedDate.IsZero() {
-			return false
+	}
+	if current < 0 {
+		return
+	}
+	target := current
+	for i := current - 1; i >= 0; i-- {
+		if isHeader(items[i]) {
+			target = i
+			break
 		}
-		if !it1.parsedDate.IsZero() && it2.parsedDate.IsZero() {
-			return true
+	}
+	m.list.Select(target)
+	m.skipHeaders(true)
+}
+
+func (m *listModel) applyFilter(query string) {
+	m.filterValue = query
+
+	// Fuzzy search and sort across all items
+	var allItems []item
+	for _, listItem := range m.allItems {
+		if it, ok := listItem.(item); ok {
+			allItems = append(allItems, it)
 		}
-		if it1.parsedDate.IsZero() && it2.parsedDate.IsZero() {
-			return it1.title < it2.title
+	}
+	matched := filterItems(query, allItems, m.rank, m.order)
+
+	// Insert section headers if grouping is on
+	m.list.SetItems(groupItems(matched, m.groupBy))
+	m.skipHeaders(true)
+}
+
+// matchCount returns the number of items left after filtering
+func (m listModel) matchCount() int {
+	count := 0
+	for _, listItem := range m.list.Items() {
+		if !isHeader(listItem) {
+			count++
 		}
-		return it1.parsedDate.Before(it2.parsedDate)
-	})
+	}
+	return count
+}
 
-	m.list.SetItems(filteredItems)
+// statusWidth is the room left for the status line at the right of the help
+func (m listModel) statusWidth() int {
+	helpWidth := lipgloss.Width(m.commonHelp.View(min(m.width, 120)))
+	return max(m.width-helpWidth-2, 0) // account for left padding
+}
+
+// statusShown is whether the status line has room on the help's line, which
+// it only gets when the help fits on one line
+func (m listModel) statusShown() bool {
+	return lipgloss.Height(m.commonHelp.View(min(m.width, 120))) == 1 && !m.status.empty()
 }
 
 func (m listModel) View() string {
@@ -330,14 +526,10 @@ func (m listModel) View() string {
 	listView := m.list.View()
 
 	var content string
-	if helpHeight == 1 && m.status != "" {
+	if m.statusShown() {
 		// Help is one line, add status on the right
-		helpWidth := lipgloss.Width(helpView)
-		statusWidth := m.width - helpWidth - 2 // account for left padding
-		if statusWidth < 0 {
-			statusWidth = 0
-		}
-		statusRight := lipgloss.NewStyle().Width(statusWidth).Align(lipgloss.Right).Render(m.status)
+		statusWidth := m.statusWidth()
+		statusRight := lipgloss.NewStyle().Width(statusWidth).Align(lipgloss.Right).Render(m.status.render(statusWidth))
 		helpWithStatus := helpView + statusRight
 		content = lipgloss.JoinVertical(lipgloss.Left, listView, helpWithStatus)
 	} else {
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"fmt"
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -15,11 +16,15 @@ type listComponent struct {
 	height       int
 	focused      bool
 	listName     string // For filtering items by list
+	pinned       bool   // Kept at the left edge, marked in the title
+	collapsed    bool   // Rendered as a narrow vertical header strip
 }
 
 func newListComponent(listName string, items []list.Item) listComponent {
 	delegateKeys := newDelegateKeyMap()
 	delegate := newItemDelegate(delegateKeys)
+	// The column title already names the list
+	delegate.hideListTag = true
 
 	l := list.New(items, delegate, 0, 0)
 	l.Title = listName
@@ -27,49 +32,64 @@ func newListComponent(listName string, items []list.Item) listComponent {
 	// Use default title style initially - will be updated when color is set
 	l.Styles.Title = titleStyle
 
+	// Keep left padding consistent with list view title padding (unchanged)
+
+	l.SetShowPagination(true)
+	l.SetShowHelp(false)
+	l.SetShowTitle(true)
+	l.SetShowFilter(false) // Disable individual filtering - use global filter
+	l.SetFilteringEnabled(false)
+	applyListKeys(&l.KeyMap)
+
+	lc := listComponent{
+		list:         l,
+		delegateKeys: delegateKeys,
+		delegate:     delegate,
+		focused:      false,
+		listName:     listName,
+	}
+	lc.applyTheme()
+	return lc
+}
+
+// applyTheme restyles the list and delegate with the current theme. The
+// title color is set separately with SetTitleColor.
+func (lc *listComponent) applyTheme() {
 	// Customize list styles with theme colors
-	l.Styles.PaginationStyle = lipgloss.NewStyle().
+	lc.list.Styles.PaginationStyle = lipgloss.NewStyle().
 		Foreground(theme.BrightBlack())
 
-	l.Styles.HelpStyle = lipgloss.NewStyle().
+	lc.list.Styles.HelpStyle = lipgloss.NewStyle().
 		Foreground(theme.BrightBlack())
 
-	l.Styles.ActivePaginationDot = lipgloss.NewStyle().
+	lc.list.Styles.ActivePaginationDot = lipgloss.NewStyle().
 		Foreground(theme.BrightCyan()).
 		SetString("•")
 
-	l.Styles.InactivePaginationDot = lipgloss.NewStyle().
+	lc.list.Styles.InactivePaginationDot = lipgloss.NewStyle().
 		Foreground(theme.BrightBlack()).
 		SetString("•")
 
-	l.Styles.FilterPrompt = lipgloss.NewStyle().
+	lc.list.Styles.FilterPrompt = lipgloss.NewStyle().
 		Foreground(theme.BrightCyan())
 
-	l.Styles.FilterCursor = lipgloss.NewStyle().
+	lc.list.Styles.FilterCursor = lipgloss.NewStyle().
 		Foreground(theme.BrightCyan())
 
-	// Keep left padding consistent with list view title padding (unchanged)
-
-	l.SetShowPagination(true)
-	l.SetShowHelp(false)
-	l.SetShowTitle(true)
-	l.SetShowFilter(false) // Disable individual filtering - use global filter
-	l.SetFilteringEnabled(false)
-
-	return listComponent{
-		list:         l,
-		delegateKeys: delegateKeys,
-		delegate:     delegate,
-		focused:      false,
-		listName:     listName,
-	}
+	lc.delegate.applyTheme()
+	lc.updateCursorStyle()
 }
 
 func (lc *listComponent) SetSize(width, height int) {
 	lc.width = width
 	lc.height = height
-	// No need to subtract for borders since we only have left border
+	// No need to subtract for borders since we only have left border.
+	// Resizing changes items per page, so reselect to keep the same item.
+	index := lc.list.Index()
 	lc.list.SetSize(width, height)
+	if index < len(lc.list.Items()) && lc.list.Index() != index {
+		lc.list.Select(index)
+	}
 
 	// Update cursor visibility based on focus
 	lc.updateCursorStyle()
@@ -132,11 +152,31 @@ func (lc *listComponent) updateCursorStyle() {
 		lc.delegate.Styles.SelectedTitle = lc.delegate.Styles.NormalTitle.Copy()
 		lc.delegate.Styles.SelectedDesc = lc.delegate.Styles.NormalDesc.Copy()
 	}
+	lc.delegate.hideSelection = !lc.focused
 
 	// Update the delegate on the list
 	lc.list.SetDelegate(lc.delegate)
 }
 
+// SetDetailed switches the extra line of fields shown when zoomed
+func (lc *listComponent) SetDetailed(detailed bool) {
+	if lc.delegate.detailed == detailed {
+		return
+	}
+	index := lc.list.Index()
+	lc.delegate.detailed = detailed
+	lc.list.SetDelegate(lc.delegate)
+	if index < len(lc.list.Items()) {
+		lc.list.Select(index)
+	}
+}
+
+// SetCompact switches between one-line and two-line items
+func (lc *listComponent) SetCompact(compact bool) {
+	lc.delegate.compact = compact
+	lc.list.SetDelegate(lc.delegate)
+}
+
 func (lc listComponent) Update(msg tea.Msg) (listComponent, tea.Cmd) {
 	// Only process navigation and selection when focused; allow size and non-key msgs
 	if !lc.focused {
@@ -162,7 +202,9 @@ func (lc listComponent) Update(msg tea.Msg) (listComponent, tea.Cmd) {
 
 func (lc listComponent) View() string {
 	var view string
-	if len(lc.list.Items()) == 0 {
+	if lc.collapsed {
+		view = lc.collapsedView()
+	} else if len(lc.list.Items()) == 0 {
 		// For empty lists, show only the title
 		view = lc.list.Styles.Title.Render(lc.list.Title) + "\n"
 	} else {
@@ -170,8 +212,13 @@ func (lc listComponent) View() string {
 	}
 	lines := strings.Split(view, "\n")
 
+	// Mark pinned columns after the title badge
+	if lc.pinned && !lc.collapsed && len(lines) > 0 {
+		lines[0] += lipgloss.NewStyle().Foreground(theme.BrightBlack()).Render(" 󰐃")
+	}
+
 	// Add focus indicator icon on the left if focused
-	if lc.focused && len(lines) > 0 {
+	if lc.focused && !lc.collapsed && len(lines) > 0 {
 		// Indicator for current list
 		focusIcon := lipgloss.NewStyle().
 			Foreground(theme.BrightCyan()).
@@ -207,8 +254,63 @@ func (lc listComponent) View() string {
 	// Combine: space + bar + space + view
 	combined := lipgloss.JoinHorizontal(lipgloss.Top, " ", barStyled, " ", view)
 
-	// Ensure fixed width
-	return lipgloss.NewStyle().Width(53).Render(combined)
+	// Ensure fixed width: content plus the space/bar/space chrome
+	return lipgloss.NewStyle().Width(lc.width + columnChromeWidth).Render(combined)
+}
+
+// collapsedView renders the title down the column, one character per line,
+// followed by the item count
+func (lc listComponent) collapsedView() string {
+	titleStyle := lc.list.Styles.Title
+	if lc.focused {
+		// Stand in for the focus icon, which doesn't fit in a strip
+		titleStyle = titleStyle.Underline(true)
+	}
+	var lines []string
+	for _, r := range []rune(lc.listName) {
+		if len(lines) >= lc.height-2 {
+			break
+		}
+		lines = append(lines, titleStyle.Render(string(r)))
+	}
+	count := lipgloss.NewStyle().
+		Foreground(theme.BrightBlack()).
+		Render(fmt.Sprintf("%d", len(lc.list.Items())))
+	lines = append(lines, "", count)
+	return strings.Join(lines, "\n")
+}
+
+// Scroll moves the cursor by one item, paging as needed
+func (lc *listComponent) Scroll(down bool) {
+	if down {
+		lc.list.CursorDown()
+	} else {
+		lc.list.CursorUp()
+	}
+}
+
+// ItemAt returns the index of the item drawn at row y of the column
+func (lc listComponent) ItemAt(y int) (int, bool) {
+	if lc.collapsed {
+		return 0, false
+	}
+	return listItemAt(lc.list, lc.delegate, y)
+}
+
+func (lc *listComponent) Select(index int) {
+	lc.list.Select(index)
+}
+
+// selectID moves the cursor to the reminder with the given external ID,
+// reporting whether it was found
+func (lc *listComponent) selectID(id string) bool {
+	for i, it := range lc.list.Items() {
+		if it, ok := it.(item); ok && it.externalID == id {
+			lc.list.Select(i)
+			return true
+		}
+	}
+	return false
 }
 
 func (lc listComponent) SelectedItem() list.Item {
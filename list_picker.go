@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -57,17 +58,20 @@ func (lp listPicker) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		lp.height = msg.Height
 		return lp, nil
 
+	case tea.MouseMsg:
+		return lp.handleMouse(msg)
+
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "up", "k":
+		switch {
+		case key.Matches(msg, keys.up):
 			if lp.cursor > 0 {
 				lp.cursor--
 			}
-		case "down", "j":
+		case key.Matches(msg, keys.down):
 			if lp.cursor < len(lp.items)-1 {
 				lp.cursor++
 			}
-		case " ", "enter":
+		case key.Matches(msg, keys.toggle, keys.confirm):
 			// Toggle the current item
 			if lp.cursor < len(lp.items) {
 				lp.items[lp.cursor].enabled = !lp.items[lp.cursor].enabled
@@ -76,7 +80,7 @@ func (lp listPicker) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					return filterChangeMsg{enabledLists: lp.getEnabledLists()}
 				}
 			}
-		case "a":
+		case key.Matches(msg, keys.enableAll):
 			// Enable all
 			for i := range lp.items {
 				lp.items[i].enabled = true
@@ -84,7 +88,7 @@ func (lp listPicker) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return lp, func() tea.Msg {
 				return filterChangeMsg{enabledLists: lp.getEnabledLists()}
 			}
-		case "n":
+		case key.Matches(msg, keys.disableAll):
 			// Disable all
 			for i := range lp.items {
 				lp.items[i].enabled = false
@@ -150,9 +154,9 @@ func (lp listPicker) View() string {
 
 		// Create colored indicator if color is configured
 		var indicator string
-		if item.color != "" {
+		if color, ok := resolveColor(item.color); ok {
 			indicatorStyle := lipgloss.NewStyle().
-				Foreground(lipgloss.Color(item.color))
+				Foreground(color)
 			indicator = indicatorStyle.Render("●")
 		}
 
@@ -182,7 +186,10 @@ func (lp listPicker) View() string {
 
 	// Help text
 	output += "\n"
-	helpText := "↑/↓ space a/n toggle all"
+	helpText := fmt.Sprintf("%s %s %s toggle all",
+		combinedHelp("move", "/", keys.up, keys.down).Help().Key,
+		combinedHelp("toggle", "/", keys.toggle).Help().Key,
+		combinedHelp("toggle all", "/", keys.enableAll, keys.disableAll).Help().Key)
 	if len(helpText) > maxWidth {
 		helpText = helpText[:maxWidth-3] + "..."
 	}
@@ -192,6 +199,68 @@ func (lp listPicker) View() string {
 	return appStyle.Render(output)
 }
 
+// Rows above the first list in the picker: top padding, title and a blank line
+const pickerItemsTop = 3
+
+// handleMouse toggles a clicked list and moves the cursor on wheel events.
+// Coordinates are relative to the picker's view.
+func (lp listPicker) handleMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	if dir := wheelDirection(msg); dir != 0 {
+		lp.cursor = max(0, min(lp.cursor+dir, len(lp.items)-1))
+		return lp, nil
+	}
+
+	row := msg.Y - pickerItemsTop
+	if !isLeftClick(msg) || row < 0 || row >= len(lp.items) {
+		return lp, nil
+	}
+	lp.cursor = row
+	lp.items[row].enabled = !lp.items[row].enabled
+	return lp, func() tea.Msg {
+		return filterChangeMsg{enabledLists: lp.getEnabledLists()}
+	}
+}
+
+// refreshColors looks up each list's color again after the config changed
+func (lp *listPicker) refreshColors() {
+	for i := range lp.items {
+		lp.items[i].color = listColorMap[strings.ToLower(lp.items[i].name)]
+	}
+}
+
+// getDisabledLists returns the lists that are unchecked
+func (lp listPicker) getDisabledLists() []string {
+	var disabled []string
+	for _, item := range lp.items {
+		if !item.enabled {
+			disabled = append(disabled, item.name)
+		}
+	}
+	return disabled
+}
+
+// getListNames returns every list in the picker
+func (lp listPicker) getListNames() []string {
+	names := make([]string, len(lp.items))
+	for i, item := range lp.items {
+		names[i] = item.name
+	}
+	return names
+}
+
+// disableLists unchecks the named lists. Names not in the picker are ignored.
+func (lp *listPicker) disableLists(names []string) {
+	disabled := make(map[string]bool, len(names))
+	for _, name := range names {
+		disabled[name] = true
+	}
+	for i := range lp.items {
+		if disabled[lp.items[i].name] {
+			lp.items[i].enabled = false
+		}
+	}
+}
+
 func (lp listPicker) getEnabledLists() []string {
 	var enabled []string
 	for _, item := range lp.items {
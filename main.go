@@ -1,7 +1,9 @@
 package main
 
 import (
+	"flag"
 	"fmt"
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
@@ -9,18 +11,11 @@ import (
 	"go.dalton.dog/bubbleup"
 	"os"
 	"os/exec"
+	"slices"
 	"strings"
 	"time"
 )
 
-type weatherMsg string
-
-func fetchWeatherCmd() tea.Cmd {
-	return tea.Cmd(func() tea.Msg {
-		return weatherMsg(getWeather())
-	})
-}
-
 // Root tabs hosting the existing views; Settings opens as a modal overlay.
 type rootModel struct {
 	tabs      []string
@@ -49,12 +44,35 @@ type rootModel struct {
 	// shared filter state
 	sharedFilter string
 
-	// weather
-	weather string
-	spinner spinner.Model
+	// weather, and the fetch schedule it comes from
+	weather       weatherMsg
+	weatherLoaded bool
+	weatherGen    int
+	spinner       spinner.Model
+	forecastOpen  bool // forecast panel above the footer
+
+	// output of the status line's command widgets, by widget index
+	widgetOutputs map[int]string
+	widgetGen     int
+
+	// how many times the summary key has jumped, to cycle through its items
+	summaryJump int
 
 	// alerts
 	alert bubbleup.AlertModel
+
+	// last item clicked, for double-click detection
+	lastClickID   string
+	lastClickTime time.Time
+
+	// version of config.toml in effect, for live reload
+	configModTime time.Time
+
+	// time of the last clock tick, to refresh items when the minute changes
+	lastTick time.Time
+
+	// last error saving UI state
+	stateErr error
 }
 
 func initialModel() rootModel {
@@ -64,10 +82,15 @@ func initialModel() rootModel {
 		lists = []string{}
 	}
 	picker := newListPicker(lists)
+	session := loadState().Session
+	picker.disableLists(session.DisabledLists)
 	enabled := picker.getEnabledLists()
 
 	// Child models
 	single := newListModel()
+	if len(session.DisabledLists) > 0 {
+		single.reloadWithFilter(enabled)
+	}
 	multi := newMultiColumnView(enabled)
 	multi.loadItems()
 
@@ -76,19 +99,16 @@ func initialModel() rootModel {
 	editList.Placeholder = "List name..."
 	editList.CharLimit = 50
 	editList.Width = 50
-	editList.CursorStyle = lipgloss.NewStyle().Foreground(theme.BrightCyan())
 
 	editTitle := textinput.New()
 	editTitle.Placeholder = "Reminder title..."
 	editTitle.CharLimit = 200
 	editTitle.Width = 50
-	editTitle.CursorStyle = lipgloss.NewStyle().Foreground(theme.BrightCyan())
 
 	editNotes := textinput.New()
 	editNotes.Placeholder = "Notes (optional)..."
 	editNotes.CharLimit = 500
 	editNotes.Width = 50
-	editNotes.CursorStyle = lipgloss.NewStyle().Foreground(theme.BrightCyan())
 
 	// Alerts
 	alert := *bubbleup.NewAlertModel(80, true)
@@ -100,7 +120,7 @@ func initialModel() rootModel {
 		FPS:    time.Second / 4,
 	}
 
-	return rootModel{
+	m := rootModel{
 		tabs:         []string{"List", "Columns"},
 		activeTab:    0,
 		single:       single,
@@ -115,14 +135,61 @@ func initialModel() rootModel {
 		editDelete:   false,
 		editItem:     nil,
 		sharedFilter: "",
-		weather:      "Loading...",
 		spinner:      s,
 		alert:        alert,
+
+		configModTime: configModTime(),
+		widgetOutputs: make(map[int]string),
 	}
+	m.styleEditInputs()
+	m.restoreSession(session)
+	return m
+}
+
+// resizeChildren sizes the views to the window, less the footer and the
+// forecast panel when it's open
+func (m *rootModel) resizeChildren() tea.Cmd {
+	// Reserve space for footer (tabs/filter) + bottom padding
+	// Footer is typically 1 line for tabs + 1 line for bottom padding
+	reservedHeight := 2
+	if m.forecastOpen {
+		reservedHeight++
+	}
+
+	// Create adjusted size message for children
+	adjustedHeight := m.height - reservedHeight
+	if adjustedHeight < 0 {
+		adjustedHeight = 0
+	}
+	adjustedMsg := tea.WindowSizeMsg{Width: m.width, Height: adjustedHeight}
+
+	// Forward adjusted size to children
+	v, singleCmd := m.single.Update(adjustedMsg)
+	m.single = v.(listModel)
+	var multiCmd tea.Cmd
+	m.multi, multiCmd = m.multi.Update(adjustedMsg)
+	return tea.Batch(singleCmd, multiCmd)
+}
+
+// styleEditInputs applies the theme to the edit overlay's inputs
+func (m *rootModel) styleEditInputs() {
+	for _, input := range []*textinput.Model{&m.editList, &m.editTitle, &m.editNotes} {
+		input.CursorStyle = lipgloss.NewStyle().Foreground(theme.BrightCyan())
+	}
+}
+
+// applyTheme restyles every view after the theme changes
+func (m *rootModel) applyTheme() {
+	m.single.applyTheme()
+	m.multi.applyTheme()
+	m.styleEditInputs()
 }
 
 func (m rootModel) Init() tea.Cmd {
-	return tea.Batch(m.alert.Init(), m.spinner.Tick, fetchWeatherCmd())
+	cmds := []tea.Cmd{m.alert.Init(), m.spinner.Tick, fetchWeatherCmd(m.weatherGen), watchConfigCmd(m.configModTime), clockTickCmd(), startWidgetsCmd(m.widgetGen)}
+	// Check the config fully and warn about any problems
+	cmds = append(cmds, checkListNamesCmd(activeConfig, configProblems))
+	return tea.Batch(cmds...)
 }
 
 func (m rootModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -131,33 +198,14 @@ func (m rootModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch t := msg.(type) {
 	case tea.WindowSizeMsg:
 		m.width, m.height = t.Width, t.Height
-
-		// Reserve space for footer (tabs/filter) + bottom padding
-		// Footer is typically 1 line for tabs + 1 line for bottom padding
-		const footerReservedHeight = 2
-
-		// Create adjusted size message for children
-		adjustedHeight := t.Height - footerReservedHeight
-		if adjustedHeight < 0 {
-			adjustedHeight = 0
-		}
-		adjustedMsg := tea.WindowSizeMsg{Width: t.Width, Height: adjustedHeight}
-
-		// Forward adjusted size to children
-		var cmd tea.Cmd
-		v, c := m.single.Update(adjustedMsg)
-		m.single = v.(listModel)
-		cmd = c
-		cmds = append(cmds, cmd)
-		m.multi, cmd = m.multi.Update(adjustedMsg)
-		cmds = append(cmds, cmd)
+		cmds = append(cmds, m.resizeChildren())
 		// picker size
 		m.picker.width, m.picker.height = t.Width, t.Height
 
 	case tea.KeyMsg:
 		if m.editOpen {
-			switch t.String() {
-			case "enter":
+			switch {
+			case key.Matches(t, keys.confirm):
 				// Save the edit
 				newList := strings.TrimSpace(m.editList.Value())
 				newTitle := strings.TrimSpace(m.editTitle.Value())
@@ -197,7 +245,7 @@ func (m rootModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 							}
 						}
 						// Refresh the data
-						m.single.reloadWithFilter(nil)
+						m.single.reloadWithFilter(m.picker.getEnabledLists())
 						m.multi.loadItems()
 					} else {
 						alertCmd := m.alert.NewAlertCmd(bubbleup.InfoKey, "Failed to update reminder")
@@ -207,44 +255,18 @@ func (m rootModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.editOpen = false
 				m.editItem = nil
 				return m, nil
-			case "esc":
+			case key.Matches(t, keys.cancel):
 				// Cancel edit
 				m.editOpen = false
 				m.editItem = nil
 				return m, nil
-			case "tab":
+			case key.Matches(t, keys.nextField):
 				// Cycle focus forward
-				m.editFocus = (m.editFocus + 1) % 5
-				m.editList.Blur()
-				m.editTitle.Blur()
-				m.editNotes.Blur()
-				switch m.editFocus {
-				case 0:
-					m.editList.Focus()
-				case 1:
-					m.editTitle.Focus()
-				case 2:
-					m.editNotes.Focus()
-				case 3, 4:
-					// checkboxes, no focus
-				}
+				m.setEditFocus((m.editFocus + 1) % 5)
 				return m, nil
-			case "shift+tab":
+			case key.Matches(t, keys.prevField):
 				// Cycle focus backward
-				m.editFocus = (m.editFocus + 4) % 5 // +4 is -1 mod 5
-				m.editList.Blur()
-				m.editTitle.Blur()
-				m.editNotes.Blur()
-				switch m.editFocus {
-				case 0:
-					m.editList.Focus()
-				case 1:
-					m.editTitle.Focus()
-				case 2:
-					m.editNotes.Focus()
-				case 3, 4:
-					// checkboxes, no focus
-				}
+				m.setEditFocus((m.editFocus + 4) % 5) // +4 is -1 mod 5
 				return m, nil
 			default:
 				// Handle input for focused field
@@ -259,8 +281,8 @@ func (m rootModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 						m.editNotes, cmd = m.editNotes.Update(msg)
 					}
 				} else {
-					// checkboxes: only handle space to toggle
-					if t.String() == " " {
+					// checkboxes: only handle the toggle key
+					if key.Matches(t, keys.toggle) {
 						if m.editFocus == 3 {
 							m.editComplete = !m.editComplete
 						} else if m.editFocus == 4 {
@@ -274,8 +296,8 @@ func (m rootModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 		if m.settingsOpen {
-			// Esc closes settings
-			if t.String() == "esc" || t.String() == "q" {
+			// Cancel or quit closes settings
+			if key.Matches(t, keys.cancel, keys.quit) {
 				m.settingsOpen = false
 				return m, nil
 			}
@@ -291,10 +313,10 @@ func (m rootModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			isFiltering = m.multi.filtering
 		}
 
-		switch t.String() {
-		case "ctrl+c":
-			return m, tea.Quit
-		case "q":
+		switch {
+		case t.String() == "ctrl+c":
+			return m, m.quit()
+		case key.Matches(t, keys.quit):
 			if isFiltering {
 				break // Let child handle it
 			}
@@ -302,97 +324,61 @@ func (m rootModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.settingsOpen = false
 				return m, nil
 			}
-			return m, tea.Quit
-		case "s":
+			return m, m.quit()
+		case key.Matches(t, keys.settings):
 			if isFiltering {
 				break // Let child handle it
 			}
 			// toggle settings overlay
 			m.settingsOpen = !m.settingsOpen
 			return m, nil
-		case "tab":
+		case key.Matches(t, keys.forecast):
+			if isFiltering || m.settingsOpen {
+				break // Let child handle it
+			}
+			m.forecastOpen = !m.forecastOpen
+			return m, m.resizeChildren()
+		case key.Matches(t, keys.summary):
+			if isFiltering || m.settingsOpen {
+				break // Let child handle it
+			}
+			return m, m.jumpToSummaryItem()
+		case key.Matches(t, keys.nextTheme, keys.prevTheme):
+			if isFiltering || m.settingsOpen {
+				break // Let child handle it
+			}
+			// Switch to the next/previous theme live
+			next := cycleTheme(key.Matches(t, keys.nextTheme))
+			m.applyTheme()
+			alertCmd := m.alert.NewAlertCmd(bubbleup.InfoKey, "Theme: "+next.DisplayName())
+			return m, alertCmd
+		case key.Matches(t, keys.nextTab):
 			if isFiltering {
 				break // Let child handle it
 			}
 			if !m.settingsOpen {
-				// Save current filter before switching
-				if m.activeTab == 0 {
-					m.sharedFilter = m.single.filterValue
-				} else {
-					m.sharedFilter = m.multi.filterValue
-				}
-
 				// Cycle to next tab (wrap around)
-				m.activeTab = (m.activeTab + 1) % len(m.tabs)
-
-				// Apply shared filter to new tab
-				if m.activeTab == 0 {
-					// Switching to single list view - apply shared filter
-					m.single.filterInput.SetValue(m.sharedFilter)
-					m.single.filterValue = m.sharedFilter
-					m.single.applyFilter(m.sharedFilter)
-				} else if m.activeTab == 1 {
-					// Switching to multi-column view - apply shared filter
-					m.multi.filterInput.SetValue(m.sharedFilter)
-					m.multi.filterValue = m.sharedFilter
-					m.multi.applyFilter(m.sharedFilter)
-
-					// ensure focus on first column
-					m.multi.focusedIndex = 0
-					if len(m.multi.listComponents) > 0 {
-						for i := range m.multi.listComponents {
-							m.multi.listComponents[i].Blur()
-						}
-						m.multi.listComponents[0].Focus()
-					}
-				}
+				m.switchTab((m.activeTab + 1) % len(m.tabs))
 			}
 			return m, tea.Batch(cmds...)
-		case "enter":
+		case key.Matches(t, keys.edit):
 			if isFiltering {
 				break // Let child handle it
 			}
 			if !m.settingsOpen {
 				// Open edit overlay for selected reminder
-				var selectedItem item
-				var ok bool
-				if m.activeTab == 0 {
-					// Single list view
-					if selected := m.single.list.SelectedItem(); selected != nil {
-						selectedItem, ok = selected.(item)
-					}
-				} else {
-					// Multi-column view
-					if selected := m.multi.listComponents[m.multi.focusedIndex].SelectedItem(); selected != nil {
-						selectedItem, ok = selected.(item)
-					}
-				}
-				if ok {
-					m.editOpen = true
-					m.editFocus = 1 // start with title
-					m.editList.SetValue(selectedItem.listName)
-					m.editTitle.SetValue(selectedItem.title)
-					m.editNotes.SetValue("") // notes not stored in item, could add if needed
-					m.editComplete = selectedItem.completed
-					m.editDelete = false // default to not delete
-					m.editItem = &selectedItem
-					m.editList.Blur()
-					m.editTitle.Focus()
-					m.editNotes.Blur()
+				if m.openEdit() {
 					return m, nil
 				}
 			}
 
-		case "shift+tab":
+		case key.Matches(t, keys.prevTab):
 			if isFiltering {
 				break // Let child handle it
 			}
 			if !m.settingsOpen {
 				// Cycle to previous tab (wrap around)
-				m.activeTab--
-				if m.activeTab < 0 {
-					m.activeTab = len(m.tabs) - 1
-				}
+				m.switchTab((m.activeTab + len(m.tabs) - 1) % len(m.tabs))
 			}
 			return m, nil
 		}
@@ -410,6 +396,9 @@ func (m rootModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			cmds = append(cmds, cmd)
 		}
 
+	case tea.MouseMsg:
+		return m.handleMouse(t)
+
 	case filterChangeMsg:
 		// Update filters for both views
 		cmd := m.single.reloadWithFilter(t.enabledLists)
@@ -422,9 +411,97 @@ func (m rootModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, cmd
 
 	case weatherMsg:
-		m.weather = string(t)
+		if t.gen != m.weatherGen {
+			return m, nil
+		}
+		m.weather = t
+		m.weatherLoaded = true
+		forecast = t.report.Days
+		if t.cacheErr != nil {
+			return m, tea.Batch(scheduleWeatherCmd(m.weatherGen), m.alert.NewAlertCmd(bubbleup.WarnKey, "caching weather: "+t.cacheErr.Error()))
+		}
+		return m, scheduleWeatherCmd(m.weatherGen)
+
+	case widgetOutputMsg:
+		if t.gen != m.widgetGen {
+			return m, nil
+		}
+		m.widgetOutputs[t.index] = t.output
+		return m, scheduleWidgetCmd(t.index, t.gen)
+
+	case widgetRefreshMsg:
+		if t.gen != m.widgetGen {
+			return m, nil
+		}
+		return m, runWidgetCmd(t.index, t.gen)
+
+	case weatherRefreshMsg:
+		if t.gen != m.weatherGen {
+			return m, nil
+		}
+		return m, fetchWeatherCmd(m.weatherGen)
+
+	case stateSaveErrMsg:
+		// Also kept for main to print, as the session is saved while quitting
+		m.stateErr = t.err
+		return m, m.alert.NewAlertCmd(bubbleup.ErrorKey, "saving state: "+t.err.Error())
+
+	case configPollMsg:
+		return m, watchConfigCmd(t.modTime)
+
+	case clockTickMsg:
+		m.handleClockTick()
+		return m, clockTickCmd()
+
+	case configProblemsMsg:
+		if len(t) > 0 {
+			return m, m.alert.NewAlertCmd(bubbleup.WarnKey, configProblemSummary(t))
+		}
 		return m, nil
 
+	case configChangedMsg:
+		m.configModTime = t.modTime
+		cmds = append(cmds, watchConfigCmd(t.modTime))
+		if t.err != nil {
+			// Keep the last good config
+			cmds = append(cmds, m.alert.NewAlertCmd(bubbleup.ErrorKey, "config: "+t.err.Error()))
+			return m, tea.Batch(cmds...)
+		}
+		prevWeather := weather
+		prevWidgets := statusWidgets
+		problems := withLines(append(t.problems, applyConfig(t.config)...))
+		cmds = append(cmds, m.reapplyConfig())
+		if weather != prevWeather {
+			// Start a new fetch schedule with the new settings
+			m.weatherGen++
+			m.weatherLoaded = false
+			cmds = append(cmds, fetchWeatherCmd(m.weatherGen))
+		}
+		if !slices.Equal(statusWidgets, prevWidgets) {
+			// Rerun command widgets for the new widget list
+			m.widgetGen++
+			m.widgetOutputs = make(map[int]string)
+			cmds = append(cmds, startWidgetsCmd(m.widgetGen))
+		}
+		if len(problems) > 0 {
+			cmds = append(cmds, m.alert.NewAlertCmd(bubbleup.WarnKey, configProblemSummary(problems)))
+		} else {
+			cmds = append(cmds, m.alert.NewAlertCmd(bubbleup.InfoKey, "Config reloaded"))
+		}
+		return m, tea.Batch(cmds...)
+
+	case filterDebounceMsg:
+		// Live filtering only happens in the active view
+		var cmd tea.Cmd
+		if m.activeTab == 0 {
+			v, c := m.single.Update(t)
+			m.single = v.(listModel)
+			cmd = c
+		} else {
+			m.multi, cmd = m.multi.Update(t)
+		}
+		return m, cmd
+
 	}
 
 	// Update picker if settings open
@@ -442,12 +519,161 @@ func (m rootModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
+// switchTab makes tab index active, carrying the current filter over to it
+func (m *rootModel) switchTab(index int) {
+	if index == m.activeTab {
+		return
+	}
+
+	// Save current filter before switching
+	if m.activeTab == 0 {
+		m.sharedFilter = m.single.filterValue
+	} else {
+		m.sharedFilter = m.multi.filterValue
+	}
+
+	m.activeTab = index
+	m.applySharedFilter()
+
+	if m.activeTab == 1 {
+		// ensure focus on first column
+		m.multi.focusedIndex = 0
+		if len(m.multi.listComponents) > 0 {
+			for i := range m.multi.listComponents {
+				m.multi.listComponents[i].Blur()
+			}
+			m.multi.listComponents[0].Focus()
+		}
+		m.multi.ensureFocusVisible()
+	}
+}
+
+// applySharedFilter applies sharedFilter to the active tab
+func (m *rootModel) applySharedFilter() {
+	if m.activeTab == 0 {
+		// Single list view
+		m.single.filterInput.SetValue(m.sharedFilter)
+		m.single.filterValue = m.sharedFilter
+		m.single.applyFilter(m.sharedFilter)
+	} else if m.activeTab == 1 {
+		// Multi-column view
+		m.multi.filterInput.SetValue(m.sharedFilter)
+		m.multi.filterValue = m.sharedFilter
+		m.multi.applyFilter(m.sharedFilter)
+	}
+}
+
+// restoreSession puts back the tab, filter, focused column and selection
+// saved on the last quit. Anything that no longer exists is skipped.
+func (m *rootModel) restoreSession(session sessionState) {
+	if session.ActiveTab > 0 && session.ActiveTab < len(m.tabs) {
+		m.activeTab = session.ActiveTab
+	}
+	m.sharedFilter = session.Filter
+	if m.sharedFilter != "" {
+		m.applySharedFilter()
+	}
+
+	if m.activeTab == 0 {
+		if session.SelectedID != "" {
+			m.single.selectID(session.SelectedID)
+		}
+		return
+	}
+	if session.FocusedColumn != "" {
+		m.multi.focusColumnNamed(session.FocusedColumn)
+	}
+	if session.SelectedID != "" && m.multi.focusedIndex >= 0 && m.multi.focusedIndex < len(m.multi.listComponents) {
+		m.multi.listComponents[m.multi.focusedIndex].selectID(session.SelectedID)
+	}
+}
+
+// sessionState captures where the user is, to restore on the next launch
+func (m rootModel) sessionState() sessionState {
+	filter := m.single.filterValue
+	if m.activeTab == 1 {
+		filter = m.multi.filterValue
+	}
+	return sessionState{
+		DisabledLists: m.picker.getDisabledLists(),
+		ActiveTab:     m.activeTab,
+		Filter:        filter,
+		FocusedColumn: m.multi.focusedColumnName(),
+		SelectedID:    m.selectedID(),
+	}
+}
+
+// quit saves the session, then exits
+func (m rootModel) quit() tea.Cmd {
+	return tea.Sequence(saveSessionStateCmd(m.sessionState(), m.picker.getListNames()), tea.Quit)
+}
+
+// openEdit opens the edit overlay for the selected reminder in the active
+// view. It returns false if nothing is selected.
+func (m *rootModel) openEdit() bool {
+	var selectedItem item
+	var ok bool
+	if m.activeTab == 0 {
+		// Single list view
+		if selected := m.single.list.SelectedItem(); selected != nil {
+			selectedItem, ok = selected.(item)
+		}
+	} else if m.multi.focusedIndex >= 0 && m.multi.focusedIndex < len(m.multi.listComponents) {
+		// Multi-column view
+		if selected := m.multi.listComponents[m.multi.focusedIndex].SelectedItem(); selected != nil {
+			selectedItem, ok = selected.(item)
+		}
+	}
+	if !ok {
+		return false
+	}
+
+	m.editOpen = true
+	m.editList.SetValue(selectedItem.listName)
+	m.editTitle.SetValue(selectedItem.title)
+	m.editNotes.SetValue("") // notes not stored in item, could add if needed
+	m.editComplete = selectedItem.completed
+	m.editDelete = false // default to not delete
+	m.editItem = &selectedItem
+	m.setEditFocus(1) // start with title
+	return true
+}
+
+// setEditFocus moves focus in the edit overlay: 0=list, 1=title, 2=notes,
+// 3=complete, 4=delete
+func (m *rootModel) setEditFocus(focus int) {
+	m.editFocus = focus
+	m.editList.Blur()
+	m.editTitle.Blur()
+	m.editNotes.Blur()
+	switch m.editFocus {
+	case 0:
+		m.editList.Focus()
+	case 1:
+		m.editTitle.Focus()
+	case 2:
+		m.editNotes.Focus()
+	case 3, 4:
+		// checkboxes, no focus
+	}
+}
+
 var (
 	// Use tint theme colors
 	docStyle = lipgloss.NewStyle().Padding(1, 2)
+
+	editModalStyle = lipgloss.NewStyle().
+			Border(lipgloss.NormalBorder()).
+			BorderForeground(theme.BrightCyan()).
+			Padding(1, 2)
+
+	settingsModalStyle = lipgloss.NewStyle().
+				Border(lipgloss.NormalBorder()).
+				BorderForeground(theme.BrightCyan()).
+				Padding(0, 0)
 )
 
-func (m rootModel) renderTabs(filterText string, isFiltering bool, filterInput string, width int) string {
+func (m rootModel) renderTabs(filterText string, isFiltering bool, filterInput string, matchCount int, rank searchRank, viewInfo string, width int) string {
 	const paddingLeft = 2
 	const paddingRight = 2
 	var parts []string
@@ -478,6 +704,13 @@ func (m rootModel) renderTabs(filterText string, isFiltering bool, filterInput s
 			Foreground(theme.BrightRed()).
 			Render(" / " + filterInput + cursor)
 		tabsRow = tabsRow + filterBox
+		// Show how many items the live filter currently matches
+		if filterInput != "" {
+			countText := lipgloss.NewStyle().
+				Foreground(theme.BrightBlack()).
+				Render(fmt.Sprintf(" (%d)", matchCount))
+			tabsRow = tabsRow + countText
+		}
 	} else if filterText != "" {
 		// Show filter indicator
 		displayValue := filterText
@@ -496,10 +729,19 @@ func (m rootModel) renderTabs(filterText string, isFiltering bool, filterInput s
 		tabsRow = tabsRow + filterPlaceholder
 	}
 
+	// Show the sort order/grouping, and the search ranking while a filter is in use
+	sortText := " " + viewInfo
+	if isFiltering || filterText != "" {
+		sortText += " [by " + rank.String() + "]"
+	}
+	tabsRow = tabsRow + lipgloss.NewStyle().
+		Foreground(theme.BrightBlack()).
+		Render(sortText)
+
 	// Add current time and date on the right
-	now := time.Now()
-	dateStr := now.Format("Monday, January 2, 2006")
-	timeStr := now.Format("15:04:05")
+	now := currentTime()
+	dateStr := dates.longDate(now)
+	timeStr := dates.clock(now)
 	dateStyled := lipgloss.NewStyle().Foreground(theme.BrightCyan()).Render("󰃭 " + dateStr)
 	timeStyled := lipgloss.NewStyle().Foreground(theme.BrightYellow()).Render("  " + timeStr)
 	timeStr = dateStyled + timeStyled
@@ -525,6 +767,9 @@ func (m rootModel) View() string {
 	var filterText string
 	var isFiltering bool
 	var filterInput string
+	var matchCount int
+	var rank searchRank
+	var viewInfo string
 
 	if m.activeTab == 0 {
 		// Single list view
@@ -533,6 +778,12 @@ func (m rootModel) View() string {
 		if isFiltering {
 			filterInput = m.single.filterInput.Value()
 		}
+		matchCount = m.single.matchCount()
+		rank = m.single.rank
+		viewInfo = "sort: " + m.single.order.String()
+		if m.single.groupBy != groupNone {
+			viewInfo += " group: " + m.single.groupBy.String()
+		}
 	} else {
 		// Multi-column view
 		isFiltering = m.multi.filtering
@@ -540,24 +791,22 @@ func (m rootModel) View() string {
 		if isFiltering {
 			filterInput = m.multi.filterInput.Value()
 		}
+		matchCount = m.multi.matchCount()
+		rank = m.multi.rank
+		viewInfo = "sort: " + m.multi.focusedOrder().String()
 	}
 
 	// Render tabs at the bottom with filter
-	footer := m.renderTabs(filterText, isFiltering, filterInput, m.width)
+	footer := m.renderTabs(filterText, isFiltering, filterInput, matchCount, rank, viewInfo, m.width)
 
 	// Add bottom padding under the footer
 	footerWithPadding := footer + "\n"
-
-	// Status line with greeting, username, and weather
-	greetingStyled := lipgloss.NewStyle().Foreground(theme.BrightCyan()).Render(getGreeting())
-	usernameStyled := lipgloss.NewStyle().Foreground(theme.BrightYellow()).Render(" " + titleCase(getUsername()) + ".")
-	var weatherStyled string
-	if m.weather == "Loading..." {
-		weatherStyled = lipgloss.NewStyle().Foreground(theme.BrightGreen()).Render(" " + m.spinner.View() + " Loading weather...")
-	} else {
-		weatherStyled = lipgloss.NewStyle().Foreground(theme.BrightGreen()).Render(" " + m.weather)
+	if m.forecastOpen {
+		footerWithPadding = forecastPanelView(m.width, currentTime()) + "\n" + footerWithPadding
 	}
-	statusStyled := greetingStyled + usernameStyled + weatherStyled + "  "
+
+	// Status line widgets
+	statusStyled := m.statusLine()
 
 	// Set status in the active view
 	if m.activeTab == 0 {
@@ -580,63 +829,14 @@ func (m rootModel) View() string {
 		return m.alert.Render(content)
 	}
 
+	var modal string
 	if m.editOpen {
-		// Edit modal
-		labelStyle := lipgloss.NewStyle().Foreground(theme.BrightCyan())
-		keyStyle := lipgloss.NewStyle().Foreground(theme.BrightBlack())
-		descStyle := lipgloss.NewStyle().Foreground(theme.Fg())
-
-		completeCheck := "[ ]"
-		if m.editComplete {
-			completeCheck = "[x]"
-		}
-		deleteCheck := "[ ]"
-		if m.editDelete {
-			deleteCheck = "[x]"
-		}
-
-		// Add cursor for focused checkboxes
-		completeLine := labelStyle.Render("Complete: ") + completeCheck
-		deleteLine := labelStyle.Render("Delete: ") + deleteCheck
-		if m.editFocus == 3 {
-			completeLine = "> " + completeLine
-		} else if m.editFocus == 4 {
-			deleteLine = "> " + deleteLine
-		}
-
-		editContent := labelStyle.Render("List: ") + m.editList.View() + "\n\n" +
-			labelStyle.Render("Title: ") + m.editTitle.View() + "\n\n" +
-			labelStyle.Render("Notes: ") + m.editNotes.View() + "\n\n" +
-			completeLine + "\n\n" +
-			deleteLine + "\n\n" +
-			keyStyle.Render("Tab") + descStyle.Render(" / ") + keyStyle.Render("Shift+Tab") + descStyle.Render(" to navigate, ") +
-			keyStyle.Render("Space") + descStyle.Render(" to toggle, ") +
-			keyStyle.Render("Enter") + descStyle.Render(" to save, ") +
-			keyStyle.Render("Esc") + descStyle.Render(" to cancel")
-		modal := lipgloss.NewStyle().
-			Border(lipgloss.NormalBorder()).
-			BorderForeground(theme.BrightCyan()).
-			Padding(1, 2).
-			Render(editContent)
-
-		// Simple centered modal substitute
-		_ = lipgloss.Width(modal)
-		fullView := lipgloss.JoinVertical(lipgloss.Left, body, footerWithPadding)
-		boxed := lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, modal)
-		dimmed := lipgloss.NewStyle().Foreground(theme.BrightBlack()).Render(fullView)
-		content := dimmed + "\n" + boxed
-		return m.alert.Render(content)
+		modal = m.editModalView()
+	} else {
+		modal = m.settingsModalView()
 	}
 
-	// Settings modal (use existing picker styled by theme)
-	modal := lipgloss.NewStyle().
-		Border(lipgloss.NormalBorder()).
-		BorderForeground(theme.BrightCyan()).
-		Padding(0, 0).
-		Render(m.picker.View())
-
 	// Simple centered modal substitute
-	_ = lipgloss.Width(modal)
 	fullView := lipgloss.JoinVertical(lipgloss.Left, body, footerWithPadding)
 	boxed := lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, modal)
 	dimmed := lipgloss.NewStyle().Foreground(theme.BrightBlack()).Render(fullView)
@@ -644,10 +844,74 @@ func (m rootModel) View() string {
 	return m.alert.Render(content)
 }
 
+// Rows of the edit modal's fields within its content, for mouse hit testing
+var editFieldRows = []int{0, 2, 4, 6, 8} // list, title, notes, complete, delete
+
+// editCheckLine renders the Complete (3) or Delete (4) checkbox field, with a
+// cursor when it's focused
+func (m rootModel) editCheckLine(field int) string {
+	label, checked := "Complete: ", m.editComplete
+	if field == 4 {
+		label, checked = "Delete: ", m.editDelete
+	}
+	check := "[ ]"
+	if checked {
+		check = "[x]"
+	}
+	line := lipgloss.NewStyle().Foreground(theme.BrightCyan()).Render(label) + check
+	if m.editFocus == field {
+		line = "> " + line
+	}
+	return line
+}
+
+func (m rootModel) editModalView() string {
+	labelStyle := lipgloss.NewStyle().Foreground(theme.BrightCyan())
+	keyStyle := lipgloss.NewStyle().Foreground(theme.BrightBlack())
+	descStyle := lipgloss.NewStyle().Foreground(theme.Fg())
+
+	editContent := labelStyle.Render("List: ") + m.editList.View() + "\n\n" +
+		labelStyle.Render("Title: ") + m.editTitle.View() + "\n\n" +
+		labelStyle.Render("Notes: ") + m.editNotes.View() + "\n\n" +
+		m.editCheckLine(3) + "\n\n" +
+		m.editCheckLine(4) + "\n\n" +
+		keyStyle.Render(combinedHelp("navigate", " / ", keys.nextField, keys.prevField).Help().Key) + descStyle.Render(" to navigate, ") +
+		keyStyle.Render(combinedHelp("toggle", "/", keys.toggle).Help().Key) + descStyle.Render(" to toggle, ") +
+		keyStyle.Render(combinedHelp("save", "/", keys.confirm).Help().Key) + descStyle.Render(" to save, ") +
+		keyStyle.Render(combinedHelp("cancel", "/", keys.cancel).Help().Key) + descStyle.Render(" to cancel")
+	return editModalStyle.Render(editContent)
+}
+
+// Settings modal (use existing picker styled by theme)
+func (m rootModel) settingsModalView() string {
+	return settingsModalStyle.Render(m.picker.View())
+}
+
 func main() {
-	p := tea.NewProgram(initialModel(), tea.WithAltScreen())
-	if _, err := p.Run(); err != nil {
+	nowFlag := flag.String("now", os.Getenv("REMINDERS_DASHBOARD_NOW"),
+		"freeze the clock at this time, e.g. 2026-03-09T09:30 (also REMINDERS_DASHBOARD_NOW)")
+	flag.Parse()
+	if *nowFlag != "" {
+		now, err := parseNow(*nowFlag)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "--now:", err)
+			os.Exit(2)
+		}
+		appClock = frozenClock(now)
+	}
+
+	// reminders-dashboard config check
+	if args := flag.Args(); len(args) == 2 && args[0] == "config" && args[1] == "check" {
+		os.Exit(runConfigCheck())
+	}
+
+	p := tea.NewProgram(initialModel(), tea.WithAltScreen(), tea.WithMouseCellMotion())
+	final, err := p.Run()
+	if err != nil {
 		fmt.Println("Error running program:", err)
 		os.Exit(1)
 	}
+	if m, ok := final.(rootModel); ok && m.stateErr != nil {
+		fmt.Fprintln(os.Stderr, "saving state:", m.stateErr)
+	}
 }
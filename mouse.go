@@ -0,0 +1,222 @@
+package main
+
+import (
+	"math"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// A second click on the same reminder within this interval opens the edit overlay
+const doubleClickInterval = 400 * time.Millisecond
+
+// Offsets of the views' content from the top-left of the screen, matching
+// the padding applied in listModel.View and multiColumnView.View
+const (
+	viewPaddingLeft = 2
+	viewPaddingTop  = 1
+	// Columns sit below the top padding, the blank lines above the lists and
+	// the blank line above each column
+	columnsTop = viewPaddingTop + 3 + 1
+)
+
+func isLeftClick(msg tea.MouseMsg) bool {
+	return msg.Action == tea.MouseActionPress && msg.Button == tea.MouseButtonLeft
+}
+
+// wheelDirection returns -1 for wheel up, 1 for wheel down and 0 otherwise
+func wheelDirection(msg tea.MouseMsg) int {
+	if msg.Action != tea.MouseActionPress {
+		return 0
+	}
+	switch msg.Button {
+	case tea.MouseButtonWheelUp:
+		return -1
+	case tea.MouseButtonWheelDown:
+		return 1
+	}
+	return 0
+}
+
+// centeredOrigin returns the top-left cell of block when centered in a
+// width x height area by lipgloss.Place
+func centeredOrigin(block string, width, height int) (int, int) {
+	center := func(gap int) int {
+		if gap <= 0 {
+			return 0
+		}
+		return gap - int(math.Round(float64(gap)*0.5))
+	}
+	return center(width - lipgloss.Width(block)), center(height - lipgloss.Height(block))
+}
+
+// listItemAt returns the index (into the visible items) of the item drawn at
+// row y of l's view, where row 0 is the top of the view
+func listItemAt(l list.Model, d list.ItemDelegate, y int) (int, bool) {
+	// Title bar and status bar sit above the items
+	header := 0
+	if l.ShowTitle() || (l.ShowFilter() && l.FilteringEnabled()) {
+		header += 1 + l.Styles.TitleBar.GetVerticalFrameSize()
+	}
+	if l.ShowStatusBar() {
+		header += 1 + l.Styles.StatusBar.GetVerticalFrameSize()
+	}
+
+	row := y - header
+	slot := d.Height() + d.Spacing()
+	if row < 0 || slot <= 0 || row%slot >= d.Height() {
+		// Above the items or on the spacing between them
+		return 0, false
+	}
+
+	start, end := l.Paginator.GetSliceBounds(len(l.VisibleItems()))
+	index := start + row/slot
+	if index >= end {
+		return 0, false
+	}
+	return index, true
+}
+
+// tabAt returns the footer tab drawn at column x, or -1
+func (m rootModel) tabAt(x int) int {
+	pos := viewPaddingLeft
+	for i, t := range m.tabs {
+		w := lipgloss.Width("[" + t + "]")
+		if x >= pos && x < pos+w {
+			return i
+		}
+		pos += w + 1 // separating space
+	}
+	return -1
+}
+
+// selectedID returns the external ID of the selected reminder in the active view
+func (m rootModel) selectedID() string {
+	var selected list.Item
+	if m.activeTab == 0 {
+		selected = m.single.list.SelectedItem()
+	} else if m.multi.focusedIndex >= 0 && m.multi.focusedIndex < len(m.multi.listComponents) {
+		selected = m.multi.listComponents[m.multi.focusedIndex].SelectedItem()
+	}
+	if it, ok := selected.(item); ok {
+		return it.externalID
+	}
+	return ""
+}
+
+func (m rootModel) handleMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	if m.editOpen {
+		return m.handleEditMouse(msg)
+	}
+
+	if m.settingsOpen {
+		// Translate to coordinates inside the modal's border
+		modal := m.settingsModalView()
+		x, y := centeredOrigin(modal, m.width, m.height)
+		msg.X -= x + settingsModalStyle.GetBorderLeftSize()
+		msg.Y -= y + settingsModalStyle.GetBorderTopSize()
+		v, cmd := m.picker.Update(msg)
+		m.picker = v.(listPicker)
+		return m, cmd
+	}
+
+	// Footer tabs sit on the line above the bottom padding
+	if isLeftClick(msg) && msg.Y == m.height-2 {
+		if tab := m.tabAt(msg.X); tab >= 0 {
+			m.switchTab(tab)
+		}
+		return m, nil
+	}
+
+	// Clicking the greeting jumps to the reminders its summary mentions
+	if isLeftClick(msg) && m.onGreeting(msg.X, msg.Y) {
+		return m, m.jumpToSummaryItem()
+	}
+
+	// Route to the active view
+	var clicked bool
+	if m.activeTab == 0 {
+		clicked = m.single.handleMouse(msg)
+	} else {
+		clicked = m.multi.handleMouse(msg)
+	}
+	if !clicked {
+		return m, nil
+	}
+
+	// A second click on the same reminder opens it for editing
+	id := m.selectedID()
+	now := time.Now()
+	if id != "" && id == m.lastClickID && now.Sub(m.lastClickTime) <= doubleClickInterval {
+		m.lastClickID = ""
+		m.openEdit()
+		return m, nil
+	}
+	m.lastClickID = id
+	m.lastClickTime = now
+	return m, nil
+}
+
+// handleEditMouse focuses fields and toggles checkboxes clicked in the edit overlay
+func (m rootModel) handleEditMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	if !isLeftClick(msg) {
+		return m, nil
+	}
+
+	modal := m.editModalView()
+	x, y := centeredOrigin(modal, m.width, m.height)
+	row := msg.Y - y - editModalStyle.GetBorderTopSize() - editModalStyle.GetPaddingTop()
+	col := msg.X - x - editModalStyle.GetBorderLeftSize() - editModalStyle.GetPaddingLeft()
+
+	for field, fieldRow := range editFieldRows {
+		if row != fieldRow {
+			continue
+		}
+		// Checkboxes only toggle on their label or box, not the blank
+		// space after them
+		if (field == 3 || field == 4) && (col < 0 || col >= lipgloss.Width(m.editCheckLine(field))) {
+			break
+		}
+		m.setEditFocus(field)
+		switch field {
+		case 3:
+			m.editComplete = !m.editComplete
+		case 4:
+			m.editDelete = !m.editDelete
+		}
+		break
+	}
+	return m, nil
+}
+
+// onGreeting reports whether screen cell x, y is on the greeting in the status
+// line. The status line is right-aligned on the views' help line, just above
+// the footer and the forecast panel when it's open.
+func (m rootModel) onGreeting(x, y int) bool {
+	statusRow := m.height - 3
+	if m.forecastOpen {
+		statusRow--
+	}
+	if y != statusRow {
+		return false
+	}
+	// The views are handed the status line when drawn, as in View
+	line := m.statusLine()
+	m.single.status, m.multi.status = line, line
+	width, shown := m.single.statusWidth(), m.single.statusShown()
+	if m.activeTab != 0 {
+		width, shown = m.multi.statusWidth(), m.multi.statusShown()
+	}
+	if !shown {
+		// The help wraps, so the status line isn't drawn at all
+		return false
+	}
+	start, end, ok := line.greetingSpan(width)
+	if !ok {
+		return false
+	}
+	left := m.width - lipgloss.Width(line.render(width))
+	return x >= left+start && x < left+end
+}
@@ -2,42 +2,22 @@ package main
 
 import (
 	"fmt"
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
-	"github.com/sahilm/fuzzy"
+	"slices"
 	"sort"
 	"strings"
+	"time"
 )
 
 // getListColor returns a color for list titles, preferring config-defined colors
 func getListColor(listName string, index int) lipgloss.TerminalColor {
-	// First try to get color from config (listColorMap is loaded in reminders.go)
-	if listColorMap != nil {
-		if configColor, exists := listColorMap[strings.ToLower(listName)]; exists && configColor != "" {
-			// Convert config color name to theme color
-			switch strings.ToLower(configColor) {
-			case "red":
-				return theme.Red()
-			case "blue":
-				return theme.Blue()
-			case "green":
-				return theme.Green()
-			case "yellow":
-				return theme.Yellow()
-			case "cyan":
-				return theme.Cyan()
-			case "magenta":
-				return theme.BrightCyan() // closest available
-			case "white":
-				return theme.White()
-			case "black":
-				return theme.BrightBlack()
-			default:
-				// If unknown color, fall back to index-based
-			}
-		}
+	// First try the color from config (listColorMap is loaded in reminders.go)
+	if c, ok := resolveColor(listColorMap[strings.ToLower(listName)]); ok {
+		return c
 	}
 
 	// Fall back to index-based colors
@@ -62,12 +42,18 @@ type multiColumnView struct {
 	filterInput textinput.Model
 	filtering   bool
 	filterValue string
+	prevFilter  string // filter in effect before "/" was pressed, restored on esc
+	filterTag   int    // incremented on each keystroke to debounce live filtering
+	rank        searchRank
+
+	// Sort order per column, keyed by list name so it survives list toggles
+	sortOrders map[string]sortOrder
 
 	// Help
 	commonHelp commonHelp
 
 	// Status line
-	status string
+	status statusLine
 
 	// Dimensions
 	width  int
@@ -76,6 +62,17 @@ type multiColumnView struct {
 	// Focus and scrolling
 	focusedIndex int // Which list column is focused (-1 means none)
 	startIndex   int // Starting index for visible columns
+
+	// Column arrangement, persisted across launches
+	columnOrder []string        // preferred order of all known lists, including disabled ones
+	pinned      map[string]bool // lists kept at the left edge
+	collapsed   map[string]bool // lists shown as a narrow header strip
+
+	// One line per reminder in every column
+	compact bool
+
+	// Show only the focused column, full size with every field
+	zoomed bool
 }
 
 func newMultiColumnView(enabledLists []string) multiColumnView {
@@ -85,37 +82,197 @@ func newMultiColumnView(enabledLists []string) multiColumnView {
 	ti.Prompt = "/"
 	ti.CharLimit = 100
 	ti.Width = 1000 // Prevent wrapping
-	ti.PromptStyle = lipgloss.NewStyle().Foreground(theme.BrightCyan())
-	ti.TextStyle = lipgloss.NewStyle().Foreground(theme.Fg())
-	ti.PlaceholderStyle = lipgloss.NewStyle().Foreground(theme.BrightBlack())
+	styleFilterInput(&ti)
 
-	// Create list components for each enabled list
-	var listComponents []listComponent
-	for _, listName := range enabledLists {
-		component := newListComponent(listName, []list.Item{})
-		// Set title color based on list name (from config) or default
-		color := getListColor(listName, 0) // Use 0 index - getListColor will use config color if available
-		component.SetTitleColor(color)
-		listComponents = append(listComponents, component)
+	// Restore the saved column arrangement
+	saved := loadState().Columns
+	pinned := make(map[string]bool)
+	for _, name := range saved.Pinned {
+		pinned[name] = true
+	}
+	collapsed := make(map[string]bool)
+	for _, name := range saved.Collapsed {
+		collapsed[name] = true
 	}
 
 	m := multiColumnView{
-		listComponents: listComponents,
-		allItems:       []item{},
-		enabledLists:   enabledLists,
-		groupedItems:   make(map[string][]item),
-		filterInput:    ti,
-		filtering:      false,
-		filterValue:    "",
-		commonHelp:     newCommonHelp(),
-		status:         "",
-		focusedIndex:   0, // Focus first list by default
-		startIndex:     0,
+		allItems:     []item{},
+		groupedItems: make(map[string][]item),
+		sortOrders:   make(map[string]sortOrder),
+		filterInput:  ti,
+		filtering:    false,
+		filterValue:  "",
+		commonHelp:   newCommonHelp(),
+		focusedIndex: 0, // Focus first list by default
+		startIndex:   0,
+		compact:      strings.EqualFold(densityDefaults.Columns, "compact"),
+		columnOrder:  saved.Order,
+		pinned:       pinned,
+		collapsed:    collapsed,
+	}
+
+	// Create list components for each enabled list, in the saved order
+	m.enabledLists = m.orderLists(enabledLists)
+	for _, listName := range m.enabledLists {
+		m.listComponents = append(m.listComponents, m.newColumn(listName))
 	}
 
 	return m
 }
 
+// applyTheme restyles the filter input, help and every column with the
+// current theme
+func (m *multiColumnView) applyTheme() {
+	styleFilterInput(&m.filterInput)
+	m.commonHelp.applyTheme()
+	for i := range m.listComponents {
+		lc := &m.listComponents[i]
+		lc.applyTheme()
+		lc.SetTitleColor(getListColor(lc.listName, 0))
+	}
+}
+
+// newColumn creates the list component for a column
+func (m multiColumnView) newColumn(listName string) listComponent {
+	component := newListComponent(listName, []list.Item{})
+	// Set title color based on list name (from config) or default
+	color := getListColor(listName, 0) // Use 0 index - getListColor will use config color if available
+	component.SetTitleColor(color)
+	component.pinned = m.pinned[listName]
+	component.collapsed = m.collapsed[listName]
+	component.SetCompact(m.compact)
+	return component
+}
+
+// orderLists returns lists in column order: pinned lists first, then the rest,
+// each by their position in columnOrder. Lists not seen before are added to
+// the end of columnOrder.
+func (m *multiColumnView) orderLists(lists []string) []string {
+	position := make(map[string]int, len(m.columnOrder))
+	for i, name := range m.columnOrder {
+		position[name] = i
+	}
+	for _, name := range lists {
+		if _, ok := position[name]; !ok {
+			position[name] = len(m.columnOrder)
+			m.columnOrder = append(m.columnOrder, name)
+		}
+	}
+
+	ordered := make([]string, len(lists))
+	copy(ordered, lists)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		pi, pj := m.pinned[ordered[i]], m.pinned[ordered[j]]
+		if pi != pj {
+			return pi
+		}
+		return position[ordered[i]] < position[ordered[j]]
+	})
+	return ordered
+}
+
+// rearrange reorders the existing columns to match columnOrder and pins,
+// keeps focus on the same list and saves the arrangement
+func (m *multiColumnView) rearrange() tea.Cmd {
+	focusedName := ""
+	if m.focusedIndex >= 0 && m.focusedIndex < len(m.listComponents) {
+		focusedName = m.listComponents[m.focusedIndex].listName
+	}
+
+	byName := make(map[string]listComponent, len(m.listComponents))
+	for _, component := range m.listComponents {
+		byName[component.listName] = component
+	}
+	m.enabledLists = m.orderLists(m.enabledLists)
+	for i, name := range m.enabledLists {
+		component := byName[name]
+		component.pinned = m.pinned[name]
+		component.collapsed = m.collapsed[name]
+		m.listComponents[i] = component
+		if name == focusedName {
+			m.focusedIndex = i
+		}
+	}
+	m.ensureFocusVisible()
+
+	return saveColumnStateCmd(m.columnState())
+}
+
+// columnState returns the arrangement to persist. The order is a copy since
+// it's saved in the background while columns keep moving.
+func (m multiColumnView) columnState() columnState {
+	state := columnState{Order: slices.Clone(m.columnOrder)}
+	for _, name := range m.columnOrder {
+		if m.pinned[name] {
+			state.Pinned = append(state.Pinned, name)
+		}
+		if m.collapsed[name] {
+			state.Collapsed = append(state.Collapsed, name)
+		}
+	}
+	return state
+}
+
+// moveFocusedColumn swaps the focused column with its neighbour in the given
+// direction. Pinned and unpinned columns don't swap with each other.
+func (m *multiColumnView) moveFocusedColumn(delta int) tea.Cmd {
+	target := m.focusedIndex + delta
+	if m.focusedIndex < 0 || target < 0 || target >= len(m.enabledLists) {
+		return nil
+	}
+	a, b := m.enabledLists[m.focusedIndex], m.enabledLists[target]
+	if m.pinned[a] != m.pinned[b] {
+		return nil
+	}
+
+	// Swap the two lists in the full order so disabled lists keep their place
+	for i, name := range m.columnOrder {
+		switch name {
+		case a:
+			m.columnOrder[i] = b
+		case b:
+			m.columnOrder[i] = a
+		}
+	}
+	return m.rearrange()
+}
+
+// togglePinFocused pins the focused column to the leftmost slot, or unpins it
+func (m *multiColumnView) togglePinFocused() tea.Cmd {
+	if m.focusedIndex < 0 || m.focusedIndex >= len(m.listComponents) {
+		return nil
+	}
+	name := m.listComponents[m.focusedIndex].listName
+	if m.pinned[name] {
+		delete(m.pinned, name)
+	} else {
+		m.pinned[name] = true
+		// Move to the front of the order so it's the leftmost pinned column
+		order := []string{name}
+		for _, other := range m.columnOrder {
+			if other != name {
+				order = append(order, other)
+			}
+		}
+		m.columnOrder = order
+	}
+	return m.rearrange()
+}
+
+// toggleCollapseFocused collapses the focused column to a narrow strip, or expands it
+func (m *multiColumnView) toggleCollapseFocused() tea.Cmd {
+	if m.focusedIndex < 0 || m.focusedIndex >= len(m.listComponents) {
+		return nil
+	}
+	name := m.listComponents[m.focusedIndex].listName
+	if m.collapsed[name] {
+		delete(m.collapsed, name)
+	} else {
+		m.collapsed[name] = true
+	}
+	return m.rearrange()
+}
+
 func (m *multiColumnView) loadItems() {
 	// Load all items (no filter by list)
 	items, err := loadRemindersFiltered(nil)
@@ -173,8 +330,10 @@ func (m *multiColumnView) updateListComponents() {
 			m.listComponents[i].SetItems(listItems)
 
 			// Update the title color based on first item's color in this list
-			if len(items) > 0 && items[0].color != "" {
-				m.listComponents[i].SetTitleColor(lipgloss.Color(items[0].color))
+			if len(items) > 0 {
+				if c, ok := resolveColor(items[0].color); ok {
+					m.listComponents[i].SetTitleColor(c)
+				}
 			}
 		}
 	}
@@ -183,58 +342,67 @@ func (m *multiColumnView) updateListComponents() {
 func (m *multiColumnView) applyFilter(query string) {
 	m.filterValue = query
 
-	var filteredItems []item
-	if query == "" {
-		filteredItems = m.allItems
-	} else {
-		// Fuzzy search across all items
-		var searchStrings []string
-		for _, item := range m.allItems {
-			searchStrings = append(searchStrings, item.title)
-		}
-
-		matches := fuzzy.Find(query, searchStrings)
-
-		filteredItems = make([]item, len(matches))
-		for i, match := range matches {
-			filteredItems[i] = m.allItems[match.Index]
-		}
+	// Group first, then fuzzy search and sort each column with its own order
+	m.groupItemsByList(m.allItems)
+	for listName, items := range m.groupedItems {
+		m.groupedItems[listName] = filterItems(query, items, m.rank, m.sortOrders[listName])
 	}
+	m.updateListComponents()
+}
 
-	// Sort filtered items by due date
-	sort.Slice(filteredItems, func(i, j int) bool {
-		// Items without due dates go to the end
-		if filteredItems[i].parsedDate.IsZero() && !filteredItems[j].parsedDate.IsZero() {
-			return false
+// refreshTimes brings every column's due labels and countdowns up to date
+// with now. Each column keeps its own sort order and selected reminder.
+func (m *multiColumnView) refreshTimes(now time.Time) {
+	selected := make([]string, len(m.listComponents))
+	for i, lc := range m.listComponents {
+		if it, ok := lc.SelectedItem().(item); ok {
+			selected[i] = it.externalID
 		}
-		if !filteredItems[i].parsedDate.IsZero() && filteredItems[j].parsedDate.IsZero() {
-			return true
-		}
-		if filteredItems[i].parsedDate.IsZero() && filteredItems[j].parsedDate.IsZero() {
-			return filteredItems[i].title < filteredItems[j].title
+	}
+	for i, it := range m.allItems {
+		m.allItems[i] = it.withTimes(now)
+	}
+	m.applyFilter(m.filterValue)
+	for i, id := range selected {
+		if id != "" && i < len(m.listComponents) {
+			m.listComponents[i].selectID(id)
 		}
-		return filteredItems[i].parsedDate.Before(filteredItems[j].parsedDate)
-	})
+	}
+}
 
-	// Regroup and update list components
-	m.groupItemsByList(filteredItems)
-	m.updateListComponents()
+// focusedOrder returns the sort order of the focused column
+func (m multiColumnView) focusedOrder() sortOrder {
+	if m.focusedIndex < 0 || m.focusedIndex >= len(m.listComponents) {
+		return sortOrder{}
+	}
+	return m.sortOrders[m.listComponents[m.focusedIndex].listName]
+}
+
+// matchCount returns the number of items left after filtering, across enabled lists
+func (m multiColumnView) matchCount() int {
+	count := 0
+	for _, items := range m.groupedItems {
+		count += len(items)
+	}
+	return count
 }
 
 func (m *multiColumnView) updateEnabledLists(enabledLists []string) {
-	m.enabledLists = enabledLists
+	zoomedName := m.focusedColumnName()
+	m.enabledLists = m.orderLists(enabledLists)
 
 	// Recreate list components for new enabled lists, preserving colors by name
 	var listComponents []listComponent
-	for _, listName := range enabledLists {
-		component := newListComponent(listName, []list.Item{})
-		// Set title color based on list name (from config) or default
-		color := getListColor(listName, 0) // Use 0 index - getListColor will use config color if available
-		component.SetTitleColor(color)
-		listComponents = append(listComponents, component)
+	for _, listName := range m.enabledLists {
+		listComponents = append(listComponents, m.newColumn(listName))
 	}
 	m.listComponents = listComponents
 
+	// Leave zoom when the zoomed column's list was disabled
+	if m.zoomed && !slices.Contains(m.enabledLists, zoomedName) {
+		m.zoomed = false
+	}
+
 	// Adjust focus and startIndex if needed
 	if len(m.listComponents) == 0 {
 		m.focusedIndex = -1
@@ -251,42 +419,139 @@ func (m *multiColumnView) updateEnabledLists(enabledLists []string) {
 			m.startIndex = 0
 		}
 		// Ensure focused is in valid range
-		columnTotalWidth := 53
-		maxVisible := m.width / columnTotalWidth
-		if maxVisible < 1 {
-			maxVisible = 1
-		}
-		if maxVisible > len(m.listComponents) {
-			maxVisible = len(m.listComponents)
+		m.ensureFocusVisible()
+	}
+
+	// Regroup items and update list components
+	m.applyFilter(m.filterValue)
+}
+
+// listNames returns the list name of each column, in column order
+func (m multiColumnView) listNames() []string {
+	names := make([]string, len(m.listComponents))
+	for i, component := range m.listComponents {
+		names[i] = component.listName
+	}
+	return names
+}
+
+// visibleWidths returns the content width of each column that fits on screen
+// starting at startIndex, according to the configured layout policy
+func (m multiColumnView) visibleWidths(startIndex int) []int {
+	const paddingLeft = 2
+	return layoutConfig.columnWidths(m.listNames(), m.collapsed, startIndex, m.width-paddingLeft)
+}
+
+// zoomWidth is the content width of the focused column when zoomed
+func (m multiColumnView) zoomWidth() int {
+	return max(m.width-viewPaddingLeft-columnChromeWidth, 10)
+}
+
+// toggleZoom zooms the focused column to fill the view, or returns to the
+// column layout. startIndex is left alone so the layout comes back as it was.
+func (m *multiColumnView) toggleZoom() {
+	if m.focusedIndex < 0 || m.focusedIndex >= len(m.listComponents) {
+		return
+	}
+	m.zoomed = !m.zoomed
+}
+
+// columnAt returns the index of the column drawn at screen column x
+func (m multiColumnView) columnAt(x int) (int, bool) {
+	if m.zoomed {
+		if m.focusedIndex < 0 || m.focusedIndex >= len(m.listComponents) {
+			return 0, false
 		}
-		if m.focusedIndex < m.startIndex {
-			m.startIndex = m.focusedIndex
+		if x >= viewPaddingLeft && x < viewPaddingLeft+m.zoomWidth()+columnChromeWidth {
+			return m.focusedIndex, true
 		}
-		if m.focusedIndex >= m.startIndex+maxVisible {
-			m.startIndex = m.focusedIndex - maxVisible + 1
+		return 0, false
+	}
+	pos := viewPaddingLeft
+	if m.startIndex > 0 {
+		pos += 2 // gap after the left scroll indicator
+	}
+	for i, w := range m.visibleWidths(m.startIndex) {
+		total := w + columnChromeWidth
+		if x >= pos && x < pos+total {
+			return m.startIndex + i, true
 		}
+		pos += total
 	}
+	return 0, false
+}
 
-	// Regroup items and update list components
-	var itemsToUse []item
-	if m.filterValue != "" {
-		// If filtering, use the filtered items logic
-		var searchStrings []string
-		for _, item := range m.allItems {
-			searchStrings = append(searchStrings, item.title)
-		}
+// focusColumn moves focus to column index
+func (m *multiColumnView) focusColumn(index int) {
+	if index == m.focusedIndex {
+		return
+	}
+	if m.focusedIndex >= 0 && m.focusedIndex < len(m.listComponents) {
+		m.listComponents[m.focusedIndex].Blur()
+	}
+	m.focusedIndex = index
+	m.listComponents[index].Focus()
+	m.ensureFocusVisible()
+}
+
+// focusedColumnName returns the list name of the focused column, or ""
+func (m multiColumnView) focusedColumnName() string {
+	if m.focusedIndex >= 0 && m.focusedIndex < len(m.listComponents) {
+		return m.listComponents[m.focusedIndex].listName
+	}
+	return ""
+}
 
-		matches := fuzzy.Find(m.filterValue, searchStrings)
-		itemsToUse = make([]item, len(matches))
-		for i, match := range matches {
-			itemsToUse[i] = m.allItems[match.Index]
+// focusColumnNamed focuses the column showing listName, reporting whether
+// there is one
+func (m *multiColumnView) focusColumnNamed(listName string) bool {
+	for i, lc := range m.listComponents {
+		if lc.listName == listName {
+			m.focusColumn(i)
+			return true
 		}
-	} else {
-		itemsToUse = m.allItems
 	}
+	return false
+}
 
-	m.groupItemsByList(itemsToUse)
-	m.updateListComponents()
+// handleMouse scrolls the column under the pointer on wheel events, and
+// focuses the clicked column and selects the clicked reminder. It reports
+// whether a reminder was clicked.
+func (m *multiColumnView) handleMouse(msg tea.MouseMsg) bool {
+	index, ok := m.columnAt(msg.X)
+	if !ok {
+		return false
+	}
+
+	if dir := wheelDirection(msg); dir != 0 {
+		m.listComponents[index].Scroll(dir > 0)
+		return false
+	}
+
+	if !isLeftClick(msg) {
+		return false
+	}
+	m.focusColumn(index)
+	itemIndex, ok := m.listComponents[index].ItemAt(msg.Y - columnsTop)
+	if !ok {
+		return false
+	}
+	m.listComponents[index].Select(itemIndex)
+	return true
+}
+
+// ensureFocusVisible scrolls so the focused column is within the visible range
+func (m *multiColumnView) ensureFocusVisible() {
+	if m.focusedIndex < 0 || len(m.listComponents) == 0 {
+		m.startIndex = 0
+		return
+	}
+	if m.focusedIndex < m.startIndex {
+		m.startIndex = m.focusedIndex
+	}
+	for m.startIndex < m.focusedIndex && m.focusedIndex >= m.startIndex+len(m.visibleWidths(m.startIndex)) {
+		m.startIndex++
+	}
 }
 
 func (m multiColumnView) Init() tea.Cmd {
@@ -301,17 +566,33 @@ func (m multiColumnView) Update(msg tea.Msg) (multiColumnView, tea.Cmd) {
 		m.width = msg.Width
 		m.height = msg.Height
 		// List components will be resized in View
+		m.ensureFocusVisible()
+		return m, nil
+
+	case filterDebounceMsg:
+		// Ignore stale ticks from earlier keystrokes
+		if m.filtering && msg.tag == m.filterTag {
+			m.applyFilter(m.filterInput.Value())
+		}
 		return m, nil
 
 	case tea.KeyMsg:
 		// If filtering, handle filter input
 		if m.filtering {
-			switch msg.String() {
-			case "esc":
+			switch {
+			case key.Matches(msg, keys.rank):
+				// Switch ranking and re-run the filter with what's typed so far
+				m.rank = m.rank.toggle()
+				m.applyFilter(m.filterInput.Value())
+				return m, nil
+			case key.Matches(msg, keys.cancel):
+				// Restore the filter that was active before we started typing
 				m.filtering = false
 				m.filterInput.Blur()
+				m.filterInput.SetValue(m.prevFilter)
+				m.applyFilter(m.prevFilter)
 				return m, nil
-			case "enter":
+			case key.Matches(msg, keys.confirm):
 				m.filtering = false
 				m.filterInput.Blur()
 				m.applyFilter(m.filterInput.Value())
@@ -320,91 +601,92 @@ func (m multiColumnView) Update(msg tea.Msg) (multiColumnView, tea.Cmd) {
 				newFilterInput, cmd := m.filterInput.Update(msg)
 				m.filterInput = newFilterInput
 				cmds = append(cmds, cmd)
+				// Re-run the filter once typing pauses
+				if m.filterInput.Value() != m.filterValue {
+					m.filterTag++
+					cmds = append(cmds, debounceFilter(m.filterTag))
+				}
 				return m, tea.Batch(cmds...)
 			}
 		}
 
-		// Handle focus switching between lists using h/l or left/right arrows
-		switch msg.String() {
-		case "right", "l":
-			if len(m.listComponents) > 0 {
-				// Calculate max visible
-				columnTotalWidth := 53 // fixed
-				maxVisible := m.width / columnTotalWidth
-				if maxVisible < 1 {
-					maxVisible = 1
-				}
-				if maxVisible > len(m.listComponents) {
-					maxVisible = len(m.listComponents)
-				}
+		// Handle zoom; column navigation and arrangement are off while zoomed
+		if key.Matches(msg, keys.zoom) {
+			m.toggleZoom()
+			return m, nil
+		}
+		if m.zoomed && key.Matches(msg, keys.left, keys.right, keys.moveColumnLeft, keys.moveColumnRight, keys.pin, keys.collapse) {
+			return m, nil
+		}
 
-				// Check if at visual right edge
-				atRightEdge := m.focusedIndex >= m.startIndex+maxVisible-1
-
-				if !atRightEdge && m.focusedIndex < len(m.listComponents)-1 {
-					// Move focus right within visible area
-					m.listComponents[m.focusedIndex].Blur()
-					m.focusedIndex++
-					m.listComponents[m.focusedIndex].Focus()
-				} else if m.startIndex+maxVisible < len(m.listComponents) {
-					// At visual right edge and can scroll right
-					m.listComponents[m.focusedIndex].Blur()
-					m.startIndex++
-					m.focusedIndex = m.startIndex + maxVisible - 1
-					m.listComponents[m.focusedIndex].Focus()
-				}
-				// Else, stay at edge
+		// Handle focus switching between lists
+		switch {
+		case key.Matches(msg, keys.right):
+			if len(m.listComponents) > 0 && m.focusedIndex < len(m.listComponents)-1 {
+				// Move focus right, scrolling if it leaves the visible area
+				m.listComponents[m.focusedIndex].Blur()
+				m.focusedIndex++
+				m.listComponents[m.focusedIndex].Focus()
+				m.ensureFocusVisible()
 			}
 			return m, nil
-		case "left", "h":
-			if len(m.listComponents) > 0 {
-				// Calculate max visible
-				columnTotalWidth := 53 // fixed
-				maxVisible := m.width / columnTotalWidth
-				if maxVisible < 1 {
-					maxVisible = 1
-				}
-				if maxVisible > len(m.listComponents) {
-					maxVisible = len(m.listComponents)
-				}
-
-				// Check if at visual left edge
-				atLeftEdge := m.focusedIndex <= m.startIndex
-
-				if !atLeftEdge && m.focusedIndex > 0 {
-					// Move focus left within visible area
-					m.listComponents[m.focusedIndex].Blur()
-					m.focusedIndex--
-					m.listComponents[m.focusedIndex].Focus()
-				} else if m.startIndex > 0 {
-					// At visual left edge and can scroll left
-					m.listComponents[m.focusedIndex].Blur()
-					m.startIndex--
-					m.focusedIndex = m.startIndex
-					m.listComponents[m.focusedIndex].Focus()
-				}
-				// Else, stay at edge
+		case key.Matches(msg, keys.left):
+			if len(m.listComponents) > 0 && m.focusedIndex > 0 {
+				// Move focus left, scrolling if it leaves the visible area
+				m.listComponents[m.focusedIndex].Blur()
+				m.focusedIndex--
+				m.listComponents[m.focusedIndex].Focus()
+				m.ensureFocusVisible()
 			}
 			return m, nil
 		}
 
-		// Handle list navigation keys - map jk to arrow keys for up/down
-		var mappedMsg tea.Msg = msg
-		switch msg.String() {
-		case "j":
-			mappedMsg = tea.KeyMsg{Type: tea.KeyDown}
-		case "k":
-			mappedMsg = tea.KeyMsg{Type: tea.KeyUp}
-		}
-
-		switch msg.String() {
-		case "/":
+		switch {
+		case key.Matches(msg, keys.filter):
 			// Start filtering
 			m.filtering = true
+			m.prevFilter = m.filterValue
 			m.filterInput.Focus()
 			return m, nil
 
-		case "esc":
+		case key.Matches(msg, keys.moveColumnLeft):
+			return m, m.moveFocusedColumn(-1)
+		case key.Matches(msg, keys.moveColumnRight):
+			return m, m.moveFocusedColumn(1)
+		case key.Matches(msg, keys.pin):
+			return m, m.togglePinFocused()
+		case key.Matches(msg, keys.density):
+			// Toggle compact density for all columns
+			m.compact = !m.compact
+			for i := range m.listComponents {
+				m.listComponents[i].SetCompact(m.compact)
+			}
+			return m, nil
+		case key.Matches(msg, keys.collapse):
+			return m, m.toggleCollapseFocused()
+
+		case key.Matches(msg, keys.sort, keys.reverseSort):
+			// Cycle sort mode or reverse direction for the focused column only
+			if m.focusedIndex >= 0 && m.focusedIndex < len(m.listComponents) {
+				listName := m.listComponents[m.focusedIndex].listName
+				if key.Matches(msg, keys.sort) {
+					m.sortOrders[listName] = m.sortOrders[listName].next()
+				} else {
+					m.sortOrders[listName] = m.sortOrders[listName].flipped()
+				}
+				m.applyFilter(m.filterValue)
+			}
+			return m, nil
+
+		case key.Matches(msg, keys.rank):
+			// Switch ranking of an applied filter
+			if m.filterValue != "" {
+				m.rank = m.rank.toggle()
+				m.applyFilter(m.filterValue)
+				return m, nil
+			}
+
+		case key.Matches(msg, keys.cancel):
 			// Clear filter
 			if m.filterValue != "" {
 				m.filterInput.SetValue("")
@@ -415,7 +697,7 @@ func (m multiColumnView) Update(msg tea.Msg) (multiColumnView, tea.Cmd) {
 
 		// Pass navigation keys to the focused list
 		if m.focusedIndex >= 0 && m.focusedIndex < len(m.listComponents) {
-			newComponent, cmd := m.listComponents[m.focusedIndex].Update(mappedMsg)
+			newComponent, cmd := m.listComponents[m.focusedIndex].Update(msg)
 			m.listComponents[m.focusedIndex] = newComponent
 			cmds = append(cmds, cmd)
 		}
@@ -426,6 +708,62 @@ func (m multiColumnView) Update(msg tea.Msg) (multiColumnView, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
+// clampStartIndex keeps startIndex on a column, scrolling back when there's
+// room to show earlier columns without hiding later ones
+func (m *multiColumnView) clampStartIndex() {
+	numLists := len(m.listComponents)
+	if m.startIndex >= numLists {
+		m.startIndex = numLists - 1
+	}
+	if m.startIndex < 0 {
+		m.startIndex = 0
+	}
+	for m.startIndex > 0 && m.startIndex-1+len(m.visibleWidths(m.startIndex-1)) >= numLists {
+		m.startIndex--
+	}
+}
+
+// scrollIndicatorView is the green label left of the help saying which
+// columns are shown, or which one is zoomed
+func (m multiColumnView) scrollIndicatorView() string {
+	numLists := len(m.listComponents)
+	if numLists == 0 {
+		return ""
+	}
+	m.clampStartIndex()
+
+	var scrollText string
+	if m.zoomed && m.focusedIndex >= 0 && m.focusedIndex < numLists {
+		scrollText = fmt.Sprintf("Zoomed %s (%d of %d) ", m.listComponents[m.focusedIndex].listName, m.focusedIndex+1, numLists)
+	} else {
+		endIndex := m.startIndex + len(m.visibleWidths(m.startIndex))
+		leftArrow := ""
+		if m.startIndex > 0 {
+			leftArrow = "◀ "
+		}
+		rightArrow := ""
+		if endIndex < numLists {
+			rightArrow = " ▶"
+		}
+		scrollText = leftArrow + fmt.Sprintf("Lists %d-%d of %d", m.startIndex+1, endIndex, numLists) + rightArrow + " "
+	}
+	return lipgloss.NewStyle().Foreground(theme.Green()).Render(scrollText)
+}
+
+// statusWidth is the room left for the status line at the right of the
+// scroll indicator and help
+func (m multiColumnView) statusWidth() int {
+	helpWidth := lipgloss.Width(m.scrollIndicatorView()) + lipgloss.Width(m.commonHelp.View(min(m.width, 120)))
+	return max(m.width-helpWidth-2, 0) // account for left padding
+}
+
+// statusShown is whether the status line has room on the help's line, which
+// it only gets when the scroll indicator and help fit on one line
+func (m multiColumnView) statusShown() bool {
+	helpLine := lipgloss.JoinHorizontal(lipgloss.Top, m.scrollIndicatorView(), m.commonHelp.View(min(m.width, 120)))
+	return lipgloss.Height(helpLine) == 1 && !m.status.empty()
+}
+
 func (m multiColumnView) View() string {
 	if m.width == 0 || m.height == 0 {
 		return ""
@@ -456,95 +794,62 @@ func (m multiColumnView) View() string {
 		return ""
 	}
 
-	// Fixed width for each column
-	const fixedColumnWidth = 50
-	listWidth := fixedColumnWidth
-
-	// Calculate column total width (including padding and border)
-	columnTotalWidth := listWidth + 3 // 50 + 1 border + 2 padding
-
-	// Calculate max visible columns
-	maxVisible := m.width / columnTotalWidth
-	if maxVisible < 1 {
-		maxVisible = 1
-	}
-	if maxVisible > numLists {
-		maxVisible = numLists
-	}
-
-	// Ensure startIndex is valid
-	if m.startIndex < 0 {
-		m.startIndex = 0
-	}
-	if m.startIndex+maxVisible > numLists {
-		m.startIndex = numLists - maxVisible
-	}
-	if m.startIndex < 0 {
-		m.startIndex = 0
-	}
-
-	// Set size for all list components
-	for i := range m.listComponents {
-		m.listComponents[i].SetSize(listWidth, listHeight)
-	}
-
-	// Render only visible list components
-	endIndex := m.startIndex + maxVisible
-	if endIndex > numLists {
-		endIndex = numLists
-	}
-	var listViews []string
-	for _, component := range m.listComponents[m.startIndex:endIndex] {
-		columnView := component.View()
-		// Add 1 line padding above each column
-		columnWithPadding := "\n" + columnView
-		listViews = append(listViews, columnWithPadding)
-	}
+	m.clampStartIndex()
+
+	var listsView string
+	if m.zoomed && m.focusedIndex >= 0 && m.focusedIndex < numLists {
+		// Zoom shows only the focused column, using the full width and height
+		zoomedColumn := &m.listComponents[m.focusedIndex]
+		// A collapsed column opens up while zoomed and collapses again after
+		zoomedColumn.collapsed = false
+		zoomedColumn.SetDetailed(true)
+		zoomedColumn.SetSize(m.zoomWidth(), listHeight)
+		listsView = "\n" + zoomedColumn.View()
+	} else {
+		// Column widths come from the layout policy and the terminal width
+		widths := m.visibleWidths(m.startIndex)
+		for i, w := range widths {
+			m.listComponents[m.startIndex+i].collapsed = m.collapsed[m.listComponents[m.startIndex+i].listName]
+			m.listComponents[m.startIndex+i].SetDetailed(false)
+			m.listComponents[m.startIndex+i].SetSize(w, listHeight)
+		}
 
-	listsView := lipgloss.JoinHorizontal(lipgloss.Top, listViews...)
+		// Render only visible list components
+		endIndex := m.startIndex + len(widths)
+		var listViews []string
+		for _, component := range m.listComponents[m.startIndex:endIndex] {
+			columnView := component.View()
+			// Add 1 line padding above each column
+			columnWithPadding := "\n" + columnView
+			listViews = append(listViews, columnWithPadding)
+		}
 
-	// Add vertical scroll indicator bars in subtle color
-	barStyle := lipgloss.NewStyle().Foreground(theme.BrightBlack())
-	barHeight := 3 // Fixed height for indicators
-	leftBar := strings.Repeat("\n", barHeight-1) + ""
-	rightBar := strings.Repeat("\n", barHeight-1) + ""
+		listsView = lipgloss.JoinHorizontal(lipgloss.Top, listViews...)
 
-	// Left bar for scrolling left
-	if m.startIndex > 0 {
-		listsView = lipgloss.JoinHorizontal(lipgloss.Center, barStyle.Render(leftBar), "  ", listsView)
-	}
-
-	// Right bar for scrolling right
-	if endIndex < numLists {
-		listsView = lipgloss.JoinHorizontal(lipgloss.Center, listsView, "  ", barStyle.Render(rightBar))
-	}
+		// Add vertical scroll indicator bars in subtle color
+		barStyle := lipgloss.NewStyle().Foreground(theme.BrightBlack())
+		barHeight := 3 // Fixed height for indicators
+		leftBar := strings.Repeat("\n", barHeight-1) + ""
+		rightBar := strings.Repeat("\n", barHeight-1) + ""
 
-	// Create scroll indicator in green
-	var scrollIndicator string
-	if numLists > 0 {
-		leftArrow := ""
+		// Left bar for scrolling left
 		if m.startIndex > 0 {
-			leftArrow = "◀ "
+			listsView = lipgloss.JoinHorizontal(lipgloss.Center, barStyle.Render(leftBar), "  ", listsView)
 		}
-		rightArrow := ""
+
+		// Right bar for scrolling right
 		if endIndex < numLists {
-			rightArrow = " ▶"
+			listsView = lipgloss.JoinHorizontal(lipgloss.Center, listsView, "  ", barStyle.Render(rightBar))
 		}
-		scrollText := leftArrow + fmt.Sprintf("Lists %d-%d of %d", m.startIndex+1, endIndex, numLists) + rightArrow + " "
-		scrollIndicator = lipgloss.NewStyle().Foreground(theme.Green()).Render(scrollText)
 	}
 
 	// Join help line horizontally
-	helpLine := lipgloss.JoinHorizontal(lipgloss.Top, scrollIndicator, helpView)
+	helpLine := lipgloss.JoinHorizontal(lipgloss.Top, m.scrollIndicatorView(), helpView)
 
 	// Add status on the right if help is one line and status exists
-	if lipgloss.Height(helpLine) == 1 && m.status != "" {
-		helpWidth := lipgloss.Width(helpLine)
-		statusWidth := m.width - helpWidth - 2 // account for left padding
-		if statusWidth < 0 {
-			statusWidth = 0
-		}
-		statusRight := lipgloss.NewStyle().Width(statusWidth).Align(lipgloss.Right).Render(m.status)
+	if m.statusShown() {
+		statusWidth := m.statusWidth()
+		statusRight := lipgloss.NewStyle().Width(statusWidth).Align(lipgloss.Right).Render(m.status.render(statusWidth))
 		helpLine = helpLine + statusRight
 	}
 
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"os"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// How often config.toml is checked for changes
+const configPollInterval = 2 * time.Second
+
+// configPollMsg schedules the next check; modTime is the version in effect
+type configPollMsg struct {
+	modTime time.Time
+}
+
+// configChangedMsg carries config.toml parsed after it changed on disk
+type configChangedMsg struct {
+	modTime  time.Time
+	config   Config
+	problems []configProblem
+	err      error
+}
+
+// configModTime returns when config.toml was last written, or the zero time
+// when there is no config file
+func configModTime() time.Time {
+	info, err := os.Stat(configPath())
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
+
+// watchConfigCmd checks config.toml after configPollInterval, parsing it if
+// it changed since modTime. Polling keeps this working on every platform and
+// when editors replace the file instead of writing it in place.
+func watchConfigCmd(modTime time.Time) tea.Cmd {
+	return tea.Tick(configPollInterval, func(time.Time) tea.Msg {
+		current := configModTime()
+		if current.Equal(modTime) {
+			return configPollMsg{modTime: modTime}
+		}
+		config, problems, err := checkConfig()
+		return configChangedMsg{modTime: current, config: config, problems: problems, err: err}
+	})
+}
+
+// reapplyConfig pushes the active config to every view after a reload
+func (m *rootModel) reapplyConfig() tea.Cmd {
+	m.picker.refreshColors()
+	m.applyTheme()
+	applyListKeys(&m.single.list.KeyMap)
+	for i := range m.multi.listComponents {
+		applyListKeys(&m.multi.listComponents[i].list.KeyMap)
+	}
+
+	// Items carry their list's color, so reload them
+	cmd := m.single.reloadWithFilter(m.picker.getEnabledLists())
+	m.multi.loadItems()
+	return cmd
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"encoding/json"
 	"fmt"
+	"maps"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -10,42 +11,100 @@ import (
 	"strings"
 	"time"
 
-	"github.com/BurntSushi/toml"
 	"github.com/charmbracelet/bubbles/list"
 )
 
 type Config struct {
-	ListColors map[string]string `toml:"listColors"`
+	Theme      string             `toml:"theme"`   // bubbletint theme name, e.g. "dracula"
+	Palette    map[string]string  `toml:"palette"` // custom colors by theme slot
+	ListColors map[string]string  `toml:"listColors"`
+	Columns    columnLayout       `toml:"columns"`
+	Density    densityConfig      `toml:"density"`
+	Keys       map[string]keyList `toml:"keys"` // action name to key(s), see keys.go
+	Urgency    urgencyConfig      `toml:"urgency"`
+	Format     formatConfig       `toml:"format"`   // locale, clock and date layouts
+	Timezone   string             `toml:"timezone"` // IANA name for due dates, default the system's
+	Weather    weatherConfig      `toml:"weather"`
+	Status     statusConfig       `toml:"status"` // status line widgets
+}
+
+// densityConfig sets the default item density per view: "compact" for one
+// line per reminder, anything else for title and description
+type densityConfig struct {
+	List    string `toml:"list"`
+	Columns string `toml:"columns"`
 }
 
 var listColorMap map[string]string
 
-func loadConfig() error {
-	configPath := filepath.Join(os.ExpandEnv("$HOME"), ".config", "reminders-dashboard", "config.toml")
+// Problems found in config.toml by the last loadConfig, shown at startup
+var configProblems []configProblem
 
-	data, err := os.ReadFile(configPath)
-	if err != nil {
-		// Config file is optional
-		listColorMap = make(map[string]string)
-		return nil
-	}
+// Active density defaults (set by loadConfig)
+var densityDefaults densityConfig
 
-	var config Config
-	if err := toml.Unmarshal(data, &config); err != nil {
-		// Invalid config, ignore and continue
-		listColorMap = make(map[string]string)
-		return nil
-	}
+func configPath() string {
+	return filepath.Join(os.ExpandEnv("$HOME"), ".config", "reminders-dashboard", "config.toml")
+}
+
+// Config in effect, kept to tell what a reload changed
+var activeConfig Config
+
+func defaultConfig() Config {
+	return Config{Columns: defaultColumnLayout()}
+}
+
+// applyConfig makes config the active configuration and reports the parts of
+// it that couldn't be used. The theme is only reset when the theme settings
+// changed, so reloading keeps a theme picked in the app.
+func applyConfig(config Config) []configProblem {
+	var problems []configProblem
 
 	// Store colors with lowercase keys for case-insensitive lookup
 	listColorMap = make(map[string]string)
 	for name, color := range config.ListColors {
 		listColorMap[strings.ToLower(name)] = color
 	}
+	layoutConfig = config.Columns.normalized()
+	densityDefaults = config.Density
+	if activeConfig.Theme != config.Theme || !maps.Equal(activeConfig.Palette, config.Palette) {
+		problems = append(problems, configureTheme(config.Theme, config.Palette)...)
+	}
+	problems = append(problems, colorProblems(config.ListColors)...)
+	problems = append(problems, configureUrgency(config.Urgency)...)
+	problems = append(problems, configureFormat(config.Format)...)
+	problems = append(problems, configureTimezone(config.Timezone)...)
+	problems = append(problems, configureWeather(config.Weather)...)
+	problems = append(problems, configureStatus(config.Status)...)
+	var keyProblems []configProblem
+	keys, keyProblems = buildKeyMap(config.Keys)
+	problems = append(problems, keyProblems...)
+
+	activeConfig = config
+	return problems
+}
 
+func loadConfig() error {
+	config, problems, err := checkConfig()
+	if err != nil {
+		// Invalid config: report it and continue with the defaults
+		configProblems = []configProblem{{msg: err.Error()}}
+		applyConfig(defaultConfig())
+		return err
+	}
+	configProblems = append(problems, applyConfig(config)...)
 	return nil
 }
 
+// configProblemSummary fits config problems into a single alert line
+func configProblemSummary(problems []configProblem) string {
+	summary := "config: " + problems[0].String()
+	if len(problems) > 1 {
+		summary += fmt.Sprintf(" (+%d more, run `reminders-dashboard config check`)", len(problems)-1)
+	}
+	return summary
+}
+
 type Reminder struct {
 	Title       string    `json:"title"`
 	DueDate     string    `json:"dueDate,omitempty"`
@@ -56,6 +115,8 @@ type Reminder struct {
 	ExternalID  string    `json:"externalId"`
 	Notes       string    `json:"notes,omitempty"`
 	parsedDate  time.Time // for sorting
+	parsedStart time.Time // for sorting by start date
+	allDay      bool      // due on a day rather than at a time
 	Color       string    // color from config
 	TimeColor   string    // color for urgency display
 }
@@ -107,34 +168,34 @@ func loadRemindersFiltered(enabledLists []string) ([]list.Item, error) {
 			}
 		}
 
-		// Parse due date for sorting
+		// Parse due and start dates for sorting
 		if r.DueDate != "" {
-			if t, err := time.Parse(time.RFC3339, r.DueDate); err == nil {
+			if t, allDay, ok := parseDueDate(r.DueDate); ok {
 				r.parsedDate = t
+				r.allDay = allDay
+			}
+		}
+		if r.StartDate != "" {
+			if t, _, ok := parseDueDate(r.StartDate); ok {
+				r.parsedStart = t
 			}
 		}
 		activeReminders = append(activeReminders, r)
 	}
 
-	// Sort by due date
-	sort.Slice(activeReminders, func(i, j int) bool {
-		// Items without due dates go to the end
-		if activeReminders[i].parsedDate.IsZero() && !activeReminders[j].parsedDate.IsZero() {
-			return false
-		}
-		if !activeReminders[i].parsedDate.IsZero() && activeReminders[j].parsedDate.IsZero() {
-			return true
-		}
-		if activeReminders[i].parsedDate.IsZero() && activeReminders[j].parsedDate.IsZero() {
-			return activeReminders[i].Title < activeReminders[j].Title
-		}
-		return activeReminders[i].parsedDate.Before(activeReminders[j].parsedDate)
+	// Convert to items and sort by due date
+	now := currentTime()
+	converted := make([]item, len(activeReminders))
+	for i, r := range activeReminders {
+		converted[i] = reminderToItem(r, now)
+	}
+	sort.SliceStable(converted, func(i, j int) bool {
+		return lessByDueDate(converted[i], converted[j])
 	})
 
-	// Convert to list items
-	items := make([]list.Item, len(activeReminders))
-	for i, r := range activeReminders {
-		items[i] = reminderToItem(r)
+	items := make([]list.Item, len(converted))
+	for i, it := range converted {
+		items[i] = it
 	}
 
 	return items, nil
@@ -172,134 +233,88 @@ func getUniqueLists() ([]string, error) {
 	return lists, nil
 }
 
-func calculateRelativeTime(dueDate time.Time) (string, string) {
-	now := time.Now()
-
-	if dueDate.Before(now) {
-		return "Overdue", "red"
+// calculateRelativeTime describes when a reminder in listName is due and
+// picks its urgency color from the configured levels, as of now. All-day
+// reminders count whole days and only become overdue once their day is over.
+func calculateRelativeTime(dueDate time.Time, allDay bool, listName string, now time.Time) (string, string) {
+	deadline := dueDeadline(dueDate, allDay)
+	diff := deadline.Sub(now)
+
+	level, urgent := urgencyFor(listName, diff)
+	var relative string
+	switch days := calendarDays(now, dueDate); {
+	case isOverdue(dueDate, allDay, now):
+		relative = dates.locale.overdue
+	case allDay && days == 0:
+		relative = dates.locale.dueToday
+	case allDay:
+		relative = dates.dueIn(time.Duration(days) * 24 * time.Hour)
+	default:
+		relative = dates.dueIn(diff)
 	}
-
-	diff := dueDate.Sub(now)
-
-	// Determine urgency color
-	urgencyColor := "" // default, no special color
-	if diff <= 24*time.Hour {
-		urgencyColor = "red"
-	} else if diff <= 3*24*time.Hour {
-		urgencyColor = "orange"
-	} else if diff <= 7*24*time.Hour {
-		urgencyColor = "yellow"
+	if !urgent {
+		return relative, ""
 	}
+	return level.text(relative), level.color
+}
 
-	// Calculate days and hours
-	days := int(diff.Hours() / 24)
-	hours := int(diff.Hours()) % 24
-
-	// Format the time string
-	var timeStr string
-	if days == 0 {
-		// Less than a day
-		if hours == 1 {
-			timeStr = "1 hour"
-		} else {
-			timeStr = fmt.Sprintf("%d hours", hours)
-		}
-	} else if days == 1 {
-		if hours == 0 {
-			timeStr = "1 day"
-		} else if hours == 1 {
-			timeStr = "1 day 1 hour"
-		} else {
-			timeStr = fmt.Sprintf("1 day %d hours", hours)
-		}
-	} else if days < 7 {
-		if hours == 0 {
-			timeStr = fmt.Sprintf("%d days", days)
-		} else if hours == 1 {
-			timeStr = fmt.Sprintf("%d days 1 hour", days)
-		} else {
-			timeStr = fmt.Sprintf("%d days %d hours", days, hours)
-		}
-	} else {
-		// Format as weeks and days
-		weeks := days / 7
-		remainingDays := days % 7
-
-		if weeks == 1 {
-			if remainingDays == 0 {
-				timeStr = "1 week"
-			} else if remainingDays == 1 {
-				timeStr = "1 week 1 day"
-			} else {
-				timeStr = fmt.Sprintf("1 week %d days", remainingDays)
-			}
-		} else {
-			if remainingDays == 0 {
-				timeStr = fmt.Sprintf("%d weeks", weeks)
-			} else if remainingDays == 1 {
-				timeStr = fmt.Sprintf("%d weeks 1 day", weeks)
-			} else {
-				timeStr = fmt.Sprintf("%d weeks %d days", weeks, remainingDays)
-			}
-		}
+func reminderToItem(r Reminder, now time.Time) item {
+	it := item{
+		title:      r.Title,
+		listName:   r.List,
+		color:      r.Color,
+		parsedDate: r.parsedDate,
+		allDay:     r.allDay,
+		startDate:  r.parsedStart,
+		priority:   r.Priority,
+		notes:      r.Notes,
+		externalID: r.ExternalID,
+		completed:  r.IsCompleted,
 	}
-
-	return "Due in " + timeStr, urgencyColor
+	return it.withTimes(now)
 }
 
-func reminderToItem(r Reminder) item {
+// withTimes fills in the parts of an item that depend on the time, as of now:
+// the due date description and the urgency text and color
+func (it item) withTimes(now time.Time) item {
 	var desc string
 
 	// Format the description with due date and list
-	if !r.parsedDate.IsZero() {
+	if !it.parsedDate.IsZero() {
 		// Format date nicely
-		now := time.Now()
-		dueDate := r.parsedDate
-
-		// Calculate relative time
-		if dueDate.Year() == now.Year() && dueDate.Month() == now.Month() && dueDate.Day() == now.Day() {
-			desc = "Today"
-		} else if dueDate.Year() == now.Year() && dueDate.Month() == now.Month() && dueDate.Day() == now.Day()+1 {
-			desc = "Tomorrow"
-		} else if dueDate.Before(now) {
-			// For overdue items, show the actual date instead of "Overdue"
-			// The urgency will be shown in the colored text
-			if dueDate.Year() == now.Year() {
-				desc = dueDate.Format("Jan 2")
-			} else {
-				desc = dueDate.Format("Jan 2, 2006")
-			}
+		now = now.In(dueLocation)
+		dueDate := it.parsedDate
+
+		// Calculate relative time by calendar day
+		days := calendarDays(now, dueDate)
+		if days == 0 {
+			desc = dates.locale.today
+		} else if days == 1 {
+			desc = dates.locale.tomorrow
+		} else if days > 0 && dates.sameWeek(now, dueDate) {
+			// Later this week: the weekday reads better than a date
+			desc = dates.weekday(dueDate)
 		} else {
-			// Show date in format like "Nov 1" or "Nov 1, 2026" if not current year
-			if dueDate.Year() == now.Year() {
-				desc = dueDate.Format("Jan 2")
-			} else {
-				desc = dueDate.Format("Jan 2, 2006")
-			}
+			// Show date in format like "Nov 1" or "Nov 1, 2026" if not current year.
+			// For overdue items the urgency is shown in the colored text.
+			desc = dates.date(dueDate, now)
+		}
+		if !it.allDay {
+			// Timed reminders show when in the day they're due
+			desc += " " + dates.timeOfDay(dueDate)
 		}
 
 		// Add list name
-		desc += " • " + r.List
+		desc += " • " + it.listName
 	} else {
-		desc = r.List
+		desc = it.listName
 	}
+	it.description = desc
 
 	// Calculate urgency text and color
-	urgencyText := ""
-	urgencyColor := ""
-	if !r.parsedDate.IsZero() {
-		urgencyText, urgencyColor = calculateRelativeTime(r.parsedDate)
-	}
-
-	return item{
-		title:        r.Title,
-		description:  desc,
-		listName:     r.List,
-		color:        r.Color,
-		urgencyText:  urgencyText,
-		urgencyColor: urgencyColor,
-		parsedDate:   r.parsedDate,
-		externalID:   r.ExternalID,
-		completed:    r.IsCompleted,
+	it.urgencyText, it.urgencyColor = "", ""
+	if !it.parsedDate.IsZero() {
+		it.urgencyText, it.urgencyColor = calculateRelativeTime(it.parsedDate, it.allDay, it.listName, now)
 	}
+	return it
 }
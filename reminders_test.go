@@ -0,0 +1,68 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+// useUrgency runs a test with the given per-list urgency levels on top of
+// the default ones, restoring the active levels afterwards
+func useUrgency(t *testing.T, lists map[string][]urgencyLevelConfig) {
+	t.Helper()
+	oldLevels, oldLists := urgencyLevels, listUrgencyLevels
+	t.Cleanup(func() { urgencyLevels, listUrgencyLevels = oldLevels, oldLists })
+	if problems := configureUrgency(urgencyConfig{Lists: lists}); len(problems) > 0 {
+		t.Fatalf("configureUrgency: %v", problems)
+	}
+}
+
+func TestCalculateRelativeTime(t *testing.T) {
+	useZones(t, "America/Toronto", "America/Toronto")
+	useUrgency(t, map[string][]urgencyLevelConfig{
+		"On call": {{Within: "2h", Label: "Due soon ({relative})", Color: "brightRed"}},
+	})
+
+	now := at(2026, 10, 14, 10, 0) // a Wednesday
+	tests := []struct {
+		name      string
+		due       time.Time
+		allDay    bool
+		list      string
+		wantText  string
+		wantColor string
+	}{
+		{"overdue timed", at(2026, 10, 14, 9, 0), false, "Work", "Overdue", "red"},
+		{"overdue all-day", at(2026, 10, 13, 0, 0), true, "Work", "Overdue", "red"},
+		{"all-day today", at(2026, 10, 14, 0, 0), true, "Work", "Due today", "red"},
+		{"last minutes", at(2026, 10, 14, 10, 0).Add(30 * time.Second), false, "Work", "Due in 1 minute", "red"},
+		{"within the hour", at(2026, 10, 14, 10, 45), false, "Work", "Due in 45 minutes", "red"},
+		{"later today", at(2026, 10, 14, 15, 0), false, "Work", "Due in 5 hours", "red"},
+		{"at the one day threshold", at(2026, 10, 15, 10, 0), false, "Work", "Due in 1 day", "red"},
+		{"all-day tomorrow", at(2026, 10, 15, 0, 0), true, "Work", "Due in 1 day", "yellow"},
+		{"days and hours", at(2026, 10, 16, 13, 0), false, "Work", "Due in 2 days 3 hours", "yellow"},
+		{"within the week", at(2026, 10, 19, 0, 0), true, "Work", "Due in 5 days", "white"},
+		{"past every level", at(2026, 10, 23, 10, 0), false, "Work", "Due in 1 week 2 days", ""},
+		{"list level with a label", at(2026, 10, 14, 11, 0), false, "On call", "Due soon (Due in 1 hour)", "brightRed"},
+		{"list level matched case-insensitively", at(2026, 10, 14, 11, 0), false, "on call", "Due soon (Due in 1 hour)", "brightRed"},
+		{"list levels replace the defaults", at(2026, 10, 15, 9, 0), false, "On call", "Due in 23 hours", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, color := calculateRelativeTime(tt.due, tt.allDay, tt.list, now)
+			if text != tt.wantText || color != tt.wantColor {
+				t.Errorf("calculateRelativeTime = %q, %q; want %q, %q", text, color, tt.wantText, tt.wantColor)
+			}
+
+			// withTimes carries the same urgency onto the item
+			it := item{listName: tt.list, parsedDate: tt.due, allDay: tt.allDay}.withTimes(now)
+			if it.urgencyText != tt.wantText || it.urgencyColor != tt.wantColor {
+				t.Errorf("withTimes urgency = %q, %q; want %q, %q", it.urgencyText, it.urgencyColor, tt.wantText, tt.wantColor)
+			}
+		})
+	}
+
+	it := item{listName: "Work"}.withTimes(now)
+	if it.urgencyText != "" || it.urgencyColor != "" || it.description != "Work" {
+		t.Errorf("undated item = %+v, want no urgency", it)
+	}
+}
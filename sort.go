@@ -0,0 +1,200 @@
+package main
+
+import (
+	"strings"
+	"time"
+)
+
+// sortMode selects the field reminders are ordered by
+type sortMode int
+
+const (
+	sortByDueDate sortMode = iota
+	sortByPriority
+	sortByTitle
+	sortByList
+	sortByStartDate
+	sortByUrgency
+	numSortModes
+)
+
+var sortModeNames = [numSortModes]string{
+	sortByDueDate:   "due",
+	sortByPriority:  "priority",
+	sortByTitle:     "title",
+	sortByList:      "list",
+	sortByStartDate: "start",
+	sortByUrgency:   "urgency",
+}
+
+func (s sortMode) String() string {
+	if s < 0 || s >= numSortModes {
+		return "due"
+	}
+	return sortModeNames[s]
+}
+
+// sortOrder is a sort mode plus direction. The zero value sorts by due date,
+// soonest first, which matches the original hard-wired ordering.
+type sortOrder struct {
+	mode    sortMode
+	reverse bool
+}
+
+// next cycles to the following sort mode, resetting the direction
+func (o sortOrder) next() sortOrder {
+	return sortOrder{mode: (o.mode + 1) % numSortModes}
+}
+
+// flipped returns the same mode in the opposite direction
+func (o sortOrder) flipped() sortOrder {
+	o.reverse = !o.reverse
+	return o
+}
+
+func (o sortOrder) String() string {
+	if o.reverse {
+		return o.mode.String() + " ↓"
+	}
+	return o.mode.String() + " ↑"
+}
+
+// less reports whether a sorts before b. Items missing the sort key (no due
+// date, no priority...) always go last regardless of direction, and ties fall
+// back to due date then title.
+func (o sortOrder) less(a, b item, now time.Time) bool {
+	if c := o.compare(a, b, now); c != 0 {
+		return c < 0
+	}
+	return lessByDueDate(a, b)
+}
+
+func (o sortOrder) compare(a, b item, now time.Time) int {
+	switch o.mode {
+	case sortByPriority:
+		return compareOptional(priorityRank(a.priority), priorityRank(b.priority), o.reverse)
+	case sortByTitle:
+		return directed(strings.Compare(strings.ToLower(a.title), strings.ToLower(b.title)), o.reverse)
+	case sortByList:
+		return directed(strings.Compare(strings.ToLower(a.listName), strings.ToLower(b.listName)), o.reverse)
+	case sortByStartDate:
+		return compareTimes(a.startDate, b.startDate, o.reverse)
+	case sortByUrgency:
+		// Most urgent first
+		sa, sb := urgencyScore(a, now), urgencyScore(b, now)
+		switch {
+		case sa > sb:
+			return directed(-1, o.reverse)
+		case sa < sb:
+			return directed(1, o.reverse)
+		}
+		return 0
+	default:
+		return compareTimes(a.parsedDate, b.parsedDate, o.reverse)
+	}
+}
+
+func directed(c int, reverse bool) int {
+	if reverse {
+		return -c
+	}
+	return c
+}
+
+// compareTimes orders earlier times first, with zero times always last
+func compareTimes(a, b time.Time, reverse bool) int {
+	switch {
+	case a.IsZero() && b.IsZero():
+		return 0
+	case a.IsZero():
+		return 1
+	case b.IsZero():
+		return -1
+	}
+	return directed(a.Compare(b), reverse)
+}
+
+// compareOptional orders smaller values first, with negative values (missing) always last
+func compareOptional(a, b int, reverse bool) int {
+	switch {
+	case a < 0 && b < 0:
+		return 0
+	case a < 0:
+		return 1
+	case b < 0:
+		return -1
+	case a < b:
+		return directed(-1, reverse)
+	case a > b:
+		return directed(1, reverse)
+	}
+	return 0
+}
+
+// priorityRank maps Reminders priorities (1-4 high, 5 medium, 6-9 low, 0 none)
+// to 0 (high) through 2 (low), or -1 when no priority is set
+func priorityRank(priority int) int {
+	switch {
+	case priority <= 0:
+		return -1
+	case priority < 5:
+		return 0
+	case priority == 5:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// priorityLabel names a Reminders priority, or returns "" when none is set
+func priorityLabel(priority int) string {
+	switch priorityRank(priority) {
+	case 0:
+		return "High"
+	case 1:
+		return "Medium"
+	case 2:
+		return "Low"
+	}
+	return ""
+}
+
+// urgencyScore combines how soon an item is due with its priority. Higher is
+// more urgent. Overdue items score above anything still upcoming.
+func urgencyScore(it item, now time.Time) float64 {
+	var score float64
+	if !it.parsedDate.IsZero() {
+		// All-day reminders count as due at the end of their day
+		days := dueDeadline(it.parsedDate, it.allDay).Sub(now).Hours() / 24
+		if days < 0 {
+			// Overdue: the longer overdue the more urgent, capped so priority still matters
+			score = 100 + min(-days, 30)
+		} else {
+			score = 100 / (1 + days)
+		}
+	}
+	switch priorityRank(it.priority) {
+	case 0:
+		score += 30
+	case 1:
+		score += 15
+	case 2:
+		score += 5
+	}
+	return score
+}
+
+// lessByDueDate orders items by due date, with undated items last sorted by title
+func lessByDueDate(a, b item) bool {
+	// Items without due dates go to the end
+	if a.parsedDate.IsZero() && !b.parsedDate.IsZero() {
+		return false
+	}
+	if !a.parsedDate.IsZero() && b.parsedDate.IsZero() {
+		return true
+	}
+	if a.parsedDate.IsZero() && b.parsedDate.IsZero() {
+		return a.title < b.title
+	}
+	return a.parsedDate.Before(b.parsedDate)
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// stateMu serializes read-modify-write cycles of the state file from commands
+var stateMu sync.Mutex
+
+// uiState is UI state saved between launches, stored as JSON in the user's
+// state dir. Each part is written by the model that owns it.
+type uiState struct {
+	Columns columnState  `json:"columns"`
+	Session sessionState `json:"session"`
+}
+
+// columnState is the arrangement of columns in the Columns tab
+type columnState struct {
+	Order     []string `json:"order,omitempty"`     // list names, leftmost first
+	Pinned    []string `json:"pinned,omitempty"`    // lists kept at the left edge
+	Collapsed []string `json:"collapsed,omitempty"` // lists shown as a narrow strip
+}
+
+// sessionState is where the user left off, saved on quit. Lists are stored
+// as disabled rather than enabled so lists created since are shown.
+type sessionState struct {
+	DisabledLists []string `json:"disabledLists,omitempty"` // unchecked in the list picker
+	ActiveTab     int      `json:"activeTab"`
+	Filter        string   `json:"filter,omitempty"`
+	FocusedColumn string   `json:"focusedColumn,omitempty"` // list name of the focused column
+	SelectedID    string   `json:"selectedId,omitempty"`    // external ID of the selected reminder
+}
+
+func statePath() string {
+	stateHome := os.Getenv("XDG_STATE_HOME")
+	if stateHome == "" {
+		stateHome = filepath.Join(os.ExpandEnv("$HOME"), ".local", "state")
+	}
+	return filepath.Join(stateHome, "reminders-dashboard", "state.json")
+}
+
+// loadState reads saved UI state. A missing or unreadable file gives empty state.
+func loadState() uiState {
+	var state uiState
+	data, err := os.ReadFile(statePath())
+	if err != nil {
+		return state
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return uiState{}
+	}
+	return state
+}
+
+func saveState(state uiState) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(statePath(), data)
+}
+
+// stateSaveErrMsg reports that saving UI state failed
+type stateSaveErrMsg struct {
+	err error
+}
+
+// saveColumnStateCmd updates the column part of the saved state in the background
+func saveColumnStateCmd(columns columnState) tea.Cmd {
+	return func() tea.Msg {
+		stateMu.Lock()
+		defer stateMu.Unlock()
+		state := loadState()
+		state.Columns = columns
+		if err := saveState(state); err != nil {
+			return stateSaveErrMsg{err}
+		}
+		return nil
+	}
+}
+
+// saveSessionStateCmd saves the session in the background. Disabled lists
+// that aren't in knownLists (e.g. lists that are empty right now) are kept so
+// they stay disabled when they come back.
+func saveSessionStateCmd(session sessionState, knownLists []string) tea.Cmd {
+	return func() tea.Msg {
+		stateMu.Lock()
+		defer stateMu.Unlock()
+		state := loadState()
+		known := make(map[string]bool, len(knownLists))
+		for _, name := range knownLists {
+			known[name] = true
+		}
+		for _, name := range state.Session.DisabledLists {
+			if !known[name] {
+				session.DisabledLists = append(session.DisabledLists, name)
+			}
+		}
+		state.Session = session
+		if err := saveState(state); err != nil {
+			return stateSaveErrMsg{err}
+		}
+		return nil
+	}
+}
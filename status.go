@@ -0,0 +1,428 @@
+package main
+
+import (
+	"os/exec"
+	"os/user"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// The status line next to the help is made of widgets, listed in order in
+// config.toml:
+//
+//	[[status.widgets]]
+//	type = "greeting"       # "Good morning Alex. 2 overdue, next: Standup at 09:30"
+//	name = "Alex"           # defaults to the login name
+//	summary = false         # just the greeting, without the day's summary
+//
+//	[[status.widgets]]
+//	type = "weather"
+//	minWidth = 120          # hidden when the terminal is narrower
+//	maxWidth = 40           # cut to this many cells
+//	priority = -1           # dropped before higher priorities when space runs out
+//
+//	[[status.widgets]]
+//	type = "command"
+//	command = "uptime"
+//	interval = "1m"         # how often to rerun it, default 1m
+//
+// Other types are clock, overdue, dueToday and gitBranch. Without a [status]
+// table the greeting and weather are shown. When the widgets don't fit, the
+// lowest priority ones are dropped first, rightmost first among equals.
+type statusConfig struct {
+	Widgets []widgetConfig `toml:"widgets"`
+}
+
+type widgetConfig struct {
+	Type     string `toml:"type"`
+	Name     string `toml:"name"`
+	Command  string `toml:"command"`
+	Interval string `toml:"interval"`
+	MinWidth int    `toml:"minWidth"`
+	MaxWidth int    `toml:"maxWidth"`
+	Priority int    `toml:"priority"`
+	Summary  *bool  `toml:"summary"`
+}
+
+// widgetKind is what a status widget shows
+type widgetKind int
+
+const (
+	widgetGreeting widgetKind = iota
+	widgetWeather
+	widgetClock
+	widgetOverdue
+	widgetDueToday
+	widgetGitBranch
+	widgetCommand
+)
+
+var widgetKinds = map[string]widgetKind{
+	"greeting":  widgetGreeting,
+	"weather":   widgetWeather,
+	"clock":     widgetClock,
+	"overdue":   widgetOverdue,
+	"duetoday":  widgetDueToday,
+	"gitbranch": widgetGitBranch,
+	"command":   widgetCommand,
+}
+
+// statusWidget is a parsed widget
+type statusWidget struct {
+	kind      widgetKind
+	name      string // greeting display name
+	noSummary bool   // greeting without the day's summary
+	command   string // shell command for command widgets
+	interval  time.Duration
+	minWidth  int
+	maxWidth  int
+	priority  int
+}
+
+// How often the git branch and command widgets rerun by default
+const defaultWidgetInterval = time.Minute
+
+func defaultStatusWidgets() []statusWidget {
+	return []statusWidget{
+		{kind: widgetGreeting},
+		{kind: widgetWeather},
+	}
+}
+
+// Active status widgets (set by loadConfig in reminders.go)
+var statusWidgets = defaultStatusWidgets()
+
+// configureStatus sets the status widgets from config, reporting widgets it
+// doesn't understand
+func configureStatus(config statusConfig) []configProblem {
+	statusWidgets = defaultStatusWidgets()
+	if len(config.Widgets) == 0 {
+		return nil
+	}
+
+	var problems []configProblem
+	key := toml.Key{"status", "widgets"}
+	var widgets []statusWidget
+	for i, c := range config.Widgets {
+		kind, ok := widgetKinds[strings.ToLower(strings.TrimSpace(c.Type))]
+		if !ok {
+			problems = append(problems, newConfigProblem(entryKey(key, i, "type"), "unknown status widget %q", c.Type))
+			continue
+		}
+		w := statusWidget{
+			kind:     kind,
+			name:     strings.TrimSpace(c.Name),
+			command:  strings.TrimSpace(c.Command),
+			interval: defaultWidgetInterval,
+			minWidth: c.MinWidth,
+			maxWidth: c.MaxWidth,
+			priority: c.Priority,
+		}
+		if c.Summary != nil {
+			w.noSummary = !*c.Summary
+		}
+		if kind == widgetCommand && w.command == "" {
+			problems = append(problems, newConfigProblem(entryKey(key, i), "command widget without a command"))
+			continue
+		}
+		if c.Interval != "" {
+			if d, ok := parseConfigDuration(c.Interval); ok && d > 0 {
+				w.interval = d
+			} else {
+				problems = append(problems, newConfigProblem(entryKey(key, i, "interval"), "invalid interval %q for %s widget", c.Interval, c.Type))
+			}
+		}
+		if c.MinWidth < 0 {
+			problems = append(problems, newConfigProblem(entryKey(key, i, "minWidth"), "minWidth for %s widget can't be negative", c.Type))
+		}
+		if c.MaxWidth < 0 {
+			problems = append(problems, newConfigProblem(entryKey(key, i, "maxWidth"), "maxWidth for %s widget can't be negative", c.Type))
+		}
+		widgets = append(widgets, w)
+	}
+	statusWidgets = widgets
+	return problems
+}
+
+// runsCommand reports whether the widget's text comes from running a command
+func (w statusWidget) runsCommand() bool {
+	return w.kind == widgetGitBranch || w.kind == widgetCommand
+}
+
+// widgetOutputMsg carries the output of a command widget. gen ties it to the
+// widget list it was started for, so reloading config drops stale schedules.
+type widgetOutputMsg struct {
+	index  int
+	gen    int
+	output string
+}
+
+// widgetRefreshMsg asks for a command widget to run again
+type widgetRefreshMsg struct {
+	index int
+	gen   int
+}
+
+// runWidgetCmd runs the command behind widget index in the background
+func runWidgetCmd(index, gen int) tea.Cmd {
+	if index >= len(statusWidgets) || !statusWidgets[index].runsCommand() {
+		return nil
+	}
+	w := statusWidgets[index]
+	return func() tea.Msg {
+		var cmd *exec.Cmd
+		if w.kind == widgetGitBranch {
+			cmd = exec.Command("git", "rev-parse", "--abbrev-ref", "HEAD")
+		} else {
+			cmd = exec.Command("sh", "-c", w.command)
+		}
+		out, err := cmd.Output()
+		if err != nil {
+			// Not a git repository, or the command failed: show nothing
+			out = nil
+		}
+		// Only the first line fits in the status line
+		line, _, _ := strings.Cut(strings.TrimSpace(string(out)), "\n")
+		return widgetOutputMsg{index: index, gen: gen, output: strings.TrimSpace(line)}
+	}
+}
+
+// scheduleWidgetCmd reruns widget index after its interval
+func scheduleWidgetCmd(index, gen int) tea.Cmd {
+	if index >= len(statusWidgets) {
+		return nil
+	}
+	return tea.Tick(statusWidgets[index].interval, func(time.Time) tea.Msg {
+		return widgetRefreshMsg{index: index, gen: gen}
+	})
+}
+
+// startWidgetsCmd runs every command widget for the first time
+func startWidgetsCmd(gen int) tea.Cmd {
+	var cmds []tea.Cmd
+	for i, w := range statusWidgets {
+		if w.runsCommand() {
+			cmds = append(cmds, runWidgetCmd(i, gen))
+		}
+	}
+	return tea.Batch(cmds...)
+}
+
+// loginName is the user's name for the greeting, looked up once
+var loginName = sync.OnceValue(func() string {
+	u, err := user.Current()
+	if err != nil {
+		return "User"
+	}
+	return titleCase(u.Username)
+})
+
+// statusPart is one rendered widget
+type statusPart struct {
+	text     string // styled
+	minWidth int
+	priority int
+	greeting bool // clicking it jumps to the reminders the summary mentions
+}
+
+// statusLine is the rendered widgets, fitted to a width when a view knows
+// how much room it has next to the help
+type statusLine struct {
+	parts     []statusPart
+	termWidth int
+}
+
+func (s statusLine) empty() bool {
+	return len(s.parts) == 0
+}
+
+// render joins the widgets that fit in width
+func (s statusLine) render(width int) string {
+	parts := s.fit(width)
+	texts := make([]string, len(parts))
+	for i, p := range parts {
+		texts[i] = p.text
+	}
+	if len(texts) == 0 {
+		return ""
+	}
+	return strings.Join(texts, " ") + statusTrailing
+}
+
+// Space after the last widget
+const statusTrailing = "  "
+
+// fit picks the widgets that fit in width. Widgets wanting a wider terminal
+// are left out, then the lowest priority ones until the rest fit.
+func (s statusLine) fit(width int) []statusPart {
+	var parts []statusPart
+	for _, p := range s.parts {
+		if p.minWidth == 0 || s.termWidth >= p.minWidth {
+			parts = append(parts, p)
+		}
+	}
+
+	lineWidth := func() int {
+		w := lipgloss.Width(statusTrailing)
+		for i, p := range parts {
+			if i > 0 {
+				w++
+			}
+			w += lipgloss.Width(p.text)
+		}
+		return w
+	}
+	for len(parts) > 0 && lineWidth() > width {
+		drop := len(parts) - 1
+		for i := len(parts) - 1; i >= 0; i-- {
+			if parts[i].priority < parts[drop].priority {
+				drop = i
+			}
+		}
+		parts = append(parts[:drop], parts[drop+1:]...)
+	}
+	return parts
+}
+
+// greetingSpan returns the cells the greeting covers in render(width), from
+// start up to end
+func (s statusLine) greetingSpan(width int) (start, end int, ok bool) {
+	for i, p := range s.fit(width) {
+		if i > 0 {
+			start++ // joining space
+		}
+		if p.greeting {
+			return start, start + lipgloss.Width(p.text), true
+		}
+		start += lipgloss.Width(p.text)
+	}
+	return 0, 0, false
+}
+
+// statusLine renders the configured widgets
+func (m rootModel) statusLine() statusLine {
+	now := currentTime()
+	line := statusLine{termWidth: m.width}
+	for i, w := range statusWidgets {
+		var text string
+		var style lipgloss.Style
+		switch w.kind {
+		case widgetGreeting:
+			name := w.name
+			if name == "" {
+				name = loginName()
+			}
+			spans := []styledSpan{
+				{getGreeting(now), lipgloss.NewStyle().Foreground(theme.BrightCyan())},
+				{" " + name + ".", lipgloss.NewStyle().Foreground(theme.BrightYellow())},
+			}
+			if !w.noSummary {
+				if summary := m.daySummary(now).text(now); summary != "" {
+					spans = append(spans, styledSpan{" " + summary, lipgloss.NewStyle().Foreground(theme.Fg())})
+				}
+			}
+			line.parts = append(line.parts, statusPart{text: renderSpans(spans, w.maxWidth), minWidth: w.minWidth, priority: w.priority, greeting: true})
+			continue
+		case widgetWeather:
+			style = lipgloss.NewStyle().Foreground(theme.BrightGreen())
+			if weather.provider == "none" {
+				// Weather turned off in config
+			} else if !m.weatherLoaded {
+				text = m.spinner.View() + " Loading weather..."
+			} else {
+				text = formatWeather(m.weather, now)
+			}
+		case widgetClock:
+			style = lipgloss.NewStyle().Foreground(theme.BrightYellow())
+			text = dates.clock(now)
+		case widgetOverdue:
+			style = lipgloss.NewStyle().Foreground(theme.Red())
+			if n := m.countItems(now, isOverdueItem); n > 0 {
+				text = counted(n, dates.locale.overdueN)
+			}
+		case widgetDueToday:
+			style = lipgloss.NewStyle().Foreground(theme.Yellow())
+			if n := m.countItems(now, isDueTodayItem); n > 0 {
+				text = counted(n, dates.locale.dueTodayN)
+			}
+		case widgetGitBranch:
+			style = lipgloss.NewStyle().Foreground(theme.Purple())
+			if branch := m.widgetOutputs[i]; branch != "" {
+				text = " " + branch
+			}
+		case widgetCommand:
+			style = lipgloss.NewStyle().Foreground(theme.Fg())
+			text = m.widgetOutputs[i]
+		}
+		if text == "" {
+			continue
+		}
+		line.parts = append(line.parts, statusPart{
+			text:     style.Render(truncateTo(text, w.maxWidth)),
+			minWidth: w.minWidth,
+			priority: w.priority,
+		})
+	}
+	return line
+}
+
+// styledSpan is a piece of a widget's text with its own style
+type styledSpan struct {
+	text  string
+	style lipgloss.Style
+}
+
+// renderSpans styles each span, cutting their combined text to width cells
+// first as truncateTo does
+func renderSpans(spans []styledSpan, width int) string {
+	var plain strings.Builder
+	for _, s := range spans {
+		plain.WriteString(s.text)
+	}
+	cut := []rune(truncateTo(plain.String(), width))
+
+	// Hand the cut text back out to the spans in order. The ellipsis takes
+	// the style of the span it lands in.
+	var out strings.Builder
+	for _, s := range spans {
+		n := min(len([]rune(s.text)), len(cut))
+		if n == 0 {
+			break
+		}
+		out.WriteString(s.style.Render(string(cut[:n])))
+		cut = cut[n:]
+	}
+	return out.String()
+}
+
+// truncateTo cuts text to width cells, or leaves it alone when width is 0
+func truncateTo(text string, width int) string {
+	if width <= 0 || lipgloss.Width(text) <= width {
+		return text
+	}
+	return truncateText(text, width)
+}
+
+func isOverdueItem(it item, now time.Time) bool {
+	return !it.parsedDate.IsZero() && isOverdue(it.parsedDate, it.allDay, now)
+}
+
+func isDueTodayItem(it item, now time.Time) bool {
+	return !it.parsedDate.IsZero() && calendarDays(now, it.parsedDate) == 0 && !isOverdue(it.parsedDate, it.allDay, now)
+}
+
+// countItems counts the reminders in enabled lists matching match
+func (m rootModel) countItems(now time.Time, match func(item, time.Time) bool) int {
+	n := 0
+	for _, listItem := range m.single.allItems {
+		if it, ok := listItem.(item); ok && match(it, now) {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"go.dalton.dog/bubbleup"
+)
+
+// Longest title shown for the next reminder in the summary
+const summaryTitleWidth = 28
+
+// daySummary is what the greeting says about the day: what's overdue, what's
+// due today and the next thing coming up
+type daySummary struct {
+	overdue  []item
+	dueToday []item
+	next     *item
+}
+
+// summarizeDay sorts items into the summary, overdue and due today soonest
+// first
+func summarizeDay(items []item, now time.Time) daySummary {
+	var s daySummary
+	for _, it := range items {
+		switch {
+		case it.parsedDate.IsZero():
+		case isOverdueItem(it, now):
+			s.overdue = append(s.overdue, it)
+		default:
+			if isDueTodayItem(it, now) {
+				s.dueToday = append(s.dueToday, it)
+			}
+			// Next is whatever stops being on time first, so a meeting at
+			// 09:30 comes before something due at some point today
+			if s.next == nil || dueDeadline(it.parsedDate, it.allDay).Before(dueDeadline(s.next.parsedDate, s.next.allDay)) {
+				next := it
+				s.next = &next
+			}
+		}
+	}
+	for _, group := range [][]item{s.overdue, s.dueToday} {
+		sort.SliceStable(group, func(i, j int) bool { return lessByDueDate(group[i], group[j]) })
+	}
+	return s
+}
+
+// text describes the summary, e.g. "2 overdue, 5 due today, next: Standup
+// at 09:30". Empty when nothing is due.
+func (s daySummary) text(now time.Time) string {
+	var parts []string
+	if n := len(s.overdue); n > 0 {
+		parts = append(parts, counted(n, dates.locale.overdueN))
+	}
+	if n := len(s.dueToday); n > 0 {
+		parts = append(parts, counted(n, dates.locale.dueTodayN))
+	}
+	if s.next != nil {
+		next := truncateTo(s.next.title, summaryTitleWidth) + " " + nextWhen(*s.next, now)
+		parts = append(parts, fmt.Sprintf(dates.locale.nextDue, next))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// nextWhen says when the next reminder is due in the locale's words: "at
+// 09:30" today, otherwise the day
+func nextWhen(it item, now time.Time) string {
+	due := it.parsedDate.In(dueLocation)
+	days := calendarDays(now, due)
+	switch {
+	case days == 0 && it.allDay:
+		return strings.ToLower(dates.locale.today)
+	case days == 0:
+		return fmt.Sprintf(dates.locale.atTime, dates.timeOfDay(due))
+	case days == 1:
+		return strings.ToLower(dates.locale.tomorrow)
+	case dates.sameWeek(now, due):
+		return dates.weekday(due)
+	}
+	return dates.date(due, now)
+}
+
+// targets lists the reminders the summary mentions, in the order jumping
+// visits them
+func (s daySummary) targets() []item {
+	targets := append(append([]item{}, s.overdue...), s.dueToday...)
+	if s.next != nil {
+		seen := false
+		for _, it := range targets {
+			seen = seen || it.externalID == s.next.externalID
+		}
+		if !seen {
+			targets = append(targets, *s.next)
+		}
+	}
+	return targets
+}
+
+// daySummary summarizes the reminders in the enabled lists
+func (m rootModel) daySummary(now time.Time) daySummary {
+	return summarizeDay(listItems(m.single.allItems), now)
+}
+
+// listItems picks the reminders out of list items
+func listItems(listItems []list.Item) []item {
+	var items []item
+	for _, listItem := range listItems {
+		if it, ok := listItem.(item); ok {
+			items = append(items, it)
+		}
+	}
+	return items
+}
+
+// jumpToSummaryItem selects the next reminder the summary mentions, cycling
+// through overdue, due today and the next one up on repeated use
+func (m *rootModel) jumpToSummaryItem() tea.Cmd {
+	targets := m.daySummary(currentTime()).targets()
+	if len(targets) == 0 {
+		return m.alert.NewAlertCmd(bubbleup.InfoKey, "Nothing due")
+	}
+	target := targets[m.summaryJump%len(targets)]
+	m.summaryJump++
+
+	var found bool
+	if m.activeTab == 0 {
+		found = m.single.selectID(target.externalID)
+	} else if m.multi.focusColumnNamed(target.listName) {
+		found = m.multi.listComponents[m.multi.focusedIndex].selectID(target.externalID)
+	}
+	if !found {
+		return m.alert.NewAlertCmd(bubbleup.WarnKey, "\""+truncateTo(target.title, summaryTitleWidth)+"\" is hidden by the filter")
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+package main
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/lipgloss"
+	tint "github.com/lrstanley/bubbletint"
+)
+
+// Theme used when config.toml doesn't name one
+var defaultTheme = tint.TintRosePine
+
+var (
+	// Theme for the app (set by loadConfig, changed live by the theme switcher)
+	theme = defaultTheme
+
+	// Themes the switcher cycles through, sorted by display name. Includes the
+	// custom palette when config.toml defines one.
+	themes = builtinThemes()
+)
+
+func builtinThemes() []tint.Tint {
+	all := tint.DefaultTints()
+	sort.Slice(all, func(i, j int) bool {
+		return strings.ToLower(all[i].DisplayName()) < strings.ToLower(all[j].DisplayName())
+	})
+	return all
+}
+
+// normalizeThemeName lets "Rose Pine", "rose-pine" and "rose_pine" all match
+func normalizeThemeName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	return strings.NewReplacer(" ", "_", "-", "_").Replace(name)
+}
+
+// findTheme looks up a theme by ID or display name, case-insensitively
+func findTheme(name string) (tint.Tint, bool) {
+	want := normalizeThemeName(name)
+	for _, t := range themes {
+		if t.ID() == want || normalizeThemeName(t.DisplayName()) == want {
+			return t, true
+		}
+	}
+	return nil, false
+}
+
+// themeIndex returns the position of the active theme in themes, or -1
+func themeIndex() int {
+	for i, t := range themes {
+		if t.ID() == theme.ID() {
+			return i
+		}
+	}
+	return -1
+}
+
+// cycleTheme switches to the next (or previous) theme, wrapping around
+func cycleTheme(forward bool) tint.Tint {
+	i := themeIndex()
+	if forward {
+		i = (i + 1) % len(themes)
+	} else {
+		i = (i - 1 + len(themes)) % len(themes)
+	}
+	setTheme(themes[i])
+	return theme
+}
+
+// setTheme makes t the active theme and rebuilds the package-level styles
+// derived from it. Models restyle themselves with their applyTheme methods.
+func setTheme(t tint.Tint) {
+	theme = t
+	titleStyle = titleStyle.
+		Foreground(theme.Bg()).
+		Background(theme.Blue())
+	editModalStyle = editModalStyle.BorderForeground(theme.BrightCyan())
+	settingsModalStyle = settingsModalStyle.BorderForeground(theme.BrightCyan())
+}
+
+// styleFilterInput applies the theme to a filter text input
+func styleFilterInput(ti *textinput.Model) {
+	ti.PromptStyle = lipgloss.NewStyle().Foreground(theme.BrightCyan())
+	ti.TextStyle = lipgloss.NewStyle().Foreground(theme.Fg())
+	ti.PlaceholderStyle = lipgloss.NewStyle().Foreground(theme.BrightBlack())
+}
+
+// customTint is a theme defined by the [palette] table in config.toml. Slots
+// the palette leaves out come from the base theme (the one named by the
+// theme key):
+//
+//	theme = "dracula"
+//
+//	[palette]
+//	bg = "#1e1e2e"
+//	blue = "#89b4fa"
+//	brightBlack = "#6c7086"
+type customTint struct {
+	base   tint.Tint
+	colors map[string]lipgloss.TerminalColor
+}
+
+// Alternative palette slot names, mapped to the slot they set
+var paletteAliases = map[string]string{
+	"foreground":    "fg",
+	"background":    "bg",
+	"magenta":       "purple",
+	"brightmagenta": "brightpurple",
+}
+
+// newCustomTint builds a custom theme from palette slot names to colors,
+// reporting unknown slots and colors
+func newCustomTint(base tint.Tint, palette map[string]string) (customTint, []configProblem) {
+	var problems []configProblem
+	colors := make(map[string]lipgloss.TerminalColor, len(palette))
+	for slot, value := range palette {
+		name := normalizeColorName(slot)
+		if alias, ok := paletteAliases[name]; ok {
+			name = alias
+		}
+		if _, ok := themeSlots[name]; !ok {
+			problems = append(problems, newConfigProblem(toml.Key{"palette", slot}, "unknown palette slot %q", slot))
+			continue
+		}
+		// Slot names in values refer to the base theme
+		c, ok := resolveColorIn(base, value)
+		if !ok {
+			problems = append(problems, newConfigProblem(toml.Key{"palette", slot}, "unknown color %q for palette slot %q", value, slot))
+			continue
+		}
+		colors[name] = c
+	}
+	return customTint{base: base, colors: colors}, problems
+}
+
+func (t customTint) color(slot string, fallback lipgloss.TerminalColor) lipgloss.TerminalColor {
+	if c, ok := t.colors[normalizeColorName(slot)]; ok {
+		return c
+	}
+	return fallback
+}
+
+func (t customTint) DisplayName() string { return "Custom" }
+func (t customTint) ID() string          { return "custom" }
+func (t customTint) About() string {
+	return "Palette from config.toml, based on " + t.base.DisplayName()
+}
+
+func (t customTint) Fg() lipgloss.TerminalColor { return t.color("fg", t.base.Fg()) }
+func (t customTint) Bg() lipgloss.TerminalColor { return t.color("bg", t.base.Bg()) }
+func (t customTint) SelectionBg() lipgloss.TerminalColor {
+	return t.color("selectionBg", t.base.SelectionBg())
+}
+func (t customTint) Cursor() lipgloss.TerminalColor { return t.color("cursor", t.base.Cursor()) }
+
+func (t customTint) BrightBlack() lipgloss.TerminalColor {
+	return t.color("brightBlack", t.base.BrightBlack())
+}
+func (t customTint) BrightBlue() lipgloss.TerminalColor {
+	return t.color("brightBlue", t.base.BrightBlue())
+}
+func (t customTint) BrightCyan() lipgloss.TerminalColor {
+	return t.color("brightCyan", t.base.BrightCyan())
+}
+func (t customTint) BrightGreen() lipgloss.TerminalColor {
+	return t.color("brightGreen", t.base.BrightGreen())
+}
+func (t customTint) BrightPurple() lipgloss.TerminalColor {
+	return t.color("brightPurple", t.base.BrightPurple())
+}
+func (t customTint) BrightRed() lipgloss.TerminalColor {
+	return t.color("brightRed", t.base.BrightRed())
+}
+func (t customTint) BrightWhite() lipgloss.TerminalColor {
+	return t.color("brightWhite", t.base.BrightWhite())
+}
+func (t customTint) BrightYellow() lipgloss.TerminalColor {
+	return t.color("brightYellow", t.base.BrightYellow())
+}
+
+func (t customTint) Black() lipgloss.TerminalColor  { return t.color("black", t.base.Black()) }
+func (t customTint) Blue() lipgloss.TerminalColor   { return t.color("blue", t.base.Blue()) }
+func (t customTint) Cyan() lipgloss.TerminalColor   { return t.color("cyan", t.base.Cyan()) }
+func (t customTint) Green() lipgloss.TerminalColor  { return t.color("green", t.base.Green()) }
+func (t customTint) Purple() lipgloss.TerminalColor { return t.color("purple", t.base.Purple()) }
+func (t customTint) Red() lipgloss.TerminalColor    { return t.color("red", t.base.Red()) }
+func (t customTint) White() lipgloss.TerminalColor  { return t.color("white", t.base.White()) }
+func (t customTint) Yellow() lipgloss.TerminalColor { return t.color("yellow", t.base.Yellow()) }
+
+// configureTheme picks the theme named in config.toml, layering the custom
+// palette over it when one is defined. Unknown names keep the default theme.
+// Reports anything in the theme settings that couldn't be used.
+func configureTheme(name string, palette map[string]string) []configProblem {
+	var problems []configProblem
+	themes = builtinThemes()
+	base := defaultTheme
+	if t, ok := findTheme(name); ok {
+		base = t
+	} else if strings.TrimSpace(name) != "" && normalizeThemeName(name) != "custom" {
+		problems = append(problems, newConfigProblem(toml.Key{"theme"}, "unknown theme %q", name))
+	}
+	if len(palette) > 0 {
+		custom, paletteProblems := newCustomTint(base, palette)
+		problems = append(problems, paletteProblems...)
+		themes = append([]tint.Tint{custom}, themes...)
+		base = custom
+	}
+	setTheme(base)
+	return problems
+}
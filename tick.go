@@ -0,0 +1,32 @@
+package main
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// clockTickMsg fires every second on the second, keeping the footer clock
+// current. Countdowns and day labels are refreshed when the minute changes.
+type clockTickMsg time.Time
+
+func clockTickCmd() tea.Cmd {
+	return tea.Every(time.Second, func(t time.Time) tea.Msg {
+		return clockTickMsg(t)
+	})
+}
+
+// handleClockTick refreshes everything that depends on the time. Items are
+// recomputed once a minute, which also rolls "Today" and "Tomorrow" over at
+// midnight; the greeting is worked out on every render.
+func (m *rootModel) handleClockTick() {
+	// Read appClock rather than the tick's time, so a frozen clock stays put
+	t := currentTime()
+	last := m.lastTick
+	m.lastTick = t
+	if !last.IsZero() && t.Truncate(time.Minute).Equal(last.Truncate(time.Minute)) {
+		return
+	}
+	m.single.refreshTimes(t)
+	m.multi.refreshTimes(t)
+}
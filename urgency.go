@@ -0,0 +1,165 @@
+package main
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+)
+
+// Urgency levels color a reminder's "Due in ..." text by how soon it is due.
+// Each level applies to reminders due within its threshold; the tightest
+// matching level wins. Lists can replace the levels entirely:
+//
+//	[[urgency.levels]]
+//	within = "0s"            # overdue
+//	color = "red"
+//
+//	[[urgency.levels]]
+//	within = "3d"
+//	color = "yellow"
+//
+//	[[urgency.lists."On call"]]
+//	within = "2h"
+//	label = "Due soon ({relative})"
+//	color = "brightRed"
+//
+// Thresholds take Go durations plus d (days) and w (weeks). A label replaces
+// the relative time text, with {relative} standing for it. Colors are
+// anything resolveColor accepts.
+type urgencyConfig struct {
+	Levels []urgencyLevelConfig            `toml:"levels"`
+	Lists  map[string][]urgencyLevelConfig `toml:"lists"`
+}
+
+type urgencyLevelConfig struct {
+	Within string `toml:"within"`
+	Label  string `toml:"label"`
+	Color  string `toml:"color"`
+}
+
+// urgencyLevel is a parsed urgency level
+type urgencyLevel struct {
+	within time.Duration
+	label  string
+	color  string
+}
+
+// defaultUrgencyLevels match the original hard-wired behavior
+func defaultUrgencyLevels() []urgencyLevel {
+	return []urgencyLevel{
+		{within: 0, color: "red"},
+		{within: 24 * time.Hour, color: "red"},
+		{within: 3 * 24 * time.Hour, color: "yellow"},
+		{within: 7 * 24 * time.Hour, color: "white"},
+	}
+}
+
+var (
+	// Active urgency levels (set by loadConfig in reminders.go)
+	urgencyLevels = defaultUrgencyLevels()
+
+	// Per-list urgency levels, keyed by lowercase list name
+	listUrgencyLevels map[string][]urgencyLevel
+)
+
+var (
+	thresholdPattern = regexp.MustCompile(`^(\d+(\.\d+)?(w|d|h|m|s|ms))+$`)
+	thresholdPart    = regexp.MustCompile(`(\d+(?:\.\d+)?)(w|d|h|ms|m|s)`)
+)
+
+var thresholdUnits = map[string]time.Duration{
+	"w":  7 * 24 * time.Hour,
+	"d":  24 * time.Hour,
+	"h":  time.Hour,
+	"m":  time.Minute,
+	"s":  time.Second,
+	"ms": time.Millisecond,
+}
+
+// parseConfigDuration parses durations from config like "2h", "3d" or "1w2d"
+func parseConfigDuration(s string) (time.Duration, bool) {
+	s = strings.ReplaceAll(strings.TrimSpace(s), " ", "")
+	if s == "0" {
+		return 0, true
+	}
+	if !thresholdPattern.MatchString(s) {
+		return 0, false
+	}
+	var total time.Duration
+	for _, m := range thresholdPart.FindAllStringSubmatch(s, -1) {
+		n, err := strconv.ParseFloat(m[1], 64)
+		if err != nil {
+			return 0, false
+		}
+		total += time.Duration(n * float64(thresholdUnits[m[2]]))
+	}
+	return total, true
+}
+
+// buildUrgencyLevels parses one set of levels, sorted tightest first
+func buildUrgencyLevels(configs []urgencyLevelConfig, key toml.Key) ([]urgencyLevel, []configProblem) {
+	var problems []configProblem
+	var levels []urgencyLevel
+	for i, c := range configs {
+		within, ok := parseConfigDuration(c.Within)
+		if !ok {
+			problems = append(problems, newConfigProblem(entryKey(key, i, "within"), "invalid urgency threshold %q, expected a duration like \"24h\" or \"3d\"", c.Within))
+			continue
+		}
+		if c.Color != "" {
+			if _, ok := resolveColor(c.Color); !ok {
+				problems = append(problems, newConfigProblem(entryKey(key, i, "color"), "unknown color %q for urgency level %q", c.Color, c.Within))
+			}
+		}
+		levels = append(levels, urgencyLevel{within: within, label: c.Label, color: c.Color})
+	}
+	sort.SliceStable(levels, func(i, j int) bool { return levels[i].within < levels[j].within })
+	return levels, problems
+}
+
+// configureUrgency sets the active urgency levels from config. Without any
+// levels configured the defaults apply.
+func configureUrgency(config urgencyConfig) []configProblem {
+	var problems []configProblem
+	urgencyLevels = defaultUrgencyLevels()
+	if len(config.Levels) > 0 {
+		var levelProblems []configProblem
+		urgencyLevels, levelProblems = buildUrgencyLevels(config.Levels, toml.Key{"urgency", "levels"})
+		problems = append(problems, levelProblems...)
+	}
+
+	listUrgencyLevels = make(map[string][]urgencyLevel)
+	for name, configs := range config.Lists {
+		levels, levelProblems := buildUrgencyLevels(configs, toml.Key{"urgency", "lists", name})
+		problems = append(problems, levelProblems...)
+		listUrgencyLevels[strings.ToLower(name)] = levels
+	}
+	return problems
+}
+
+// urgencyFor finds the level for a reminder in listName due in diff (negative
+// when overdue). ok is false when no level applies.
+func urgencyFor(listName string, diff time.Duration) (urgencyLevel, bool) {
+	levels := urgencyLevels
+	if l, ok := listUrgencyLevels[strings.ToLower(listName)]; ok {
+		levels = l
+	}
+	for _, level := range levels {
+		if diff <= level.within {
+			return level, true
+		}
+	}
+	return urgencyLevel{}, false
+}
+
+// text is the urgency text for a reminder, given its relative time
+func (l urgencyLevel) text(relative string) string {
+	if l.label == "" {
+		return relative
+	}
+	return strings.ReplaceAll(l.label, "{relative}", relative)
+}
@@ -2,62 +2,347 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
-	"os/exec"
+	"net/url"
+	"os"
+	"path/filepath"
 	"strings"
 	"time"
+
+	"github.com/BurntSushi/toml"
+	tea "github.com/charmbracelet/bubbletea"
 )
 
+// weatherConfig is the [weather] table in config.toml:
+//
+//	[weather]
+//	provider = "wttr"            # or "none" to hide the weather
+//	location = "Waterloo Ontario"
+//	units = "metric"             # or "imperial"
+//	refresh = "30m"              # how often to fetch
+//	cacheTTL = "1h"              # how long a cached report is used without fetching
+//	url = "http://wttr.in"       # base URL, e.g. a local stand-in
+type weatherConfig struct {
+	Provider string `toml:"provider"`
+	Location string `toml:"location"`
+	Units    string `toml:"units"`
+	Refresh  string `toml:"refresh"`
+	CacheTTL string `toml:"cacheTTL"`
+	URL      string `toml:"url"`
+}
+
+// weatherSettings is the parsed [weather] table
+type weatherSettings struct {
+	provider string
+	location string
+	imperial bool
+	refresh  time.Duration
+	cacheTTL time.Duration
+	baseURL  string
+}
+
+func defaultWeatherSettings() weatherSettings {
+	return weatherSettings{
+		provider: "wttr",
+		location: "Waterloo Ontario",
+		refresh:  30 * time.Minute,
+		cacheTTL: time.Hour,
+		baseURL:  "http://wttr.in",
+	}
+}
+
+// Active weather settings (set by loadConfig in reminders.go)
+var weather = defaultWeatherSettings()
+
+// configureWeather sets the active weather settings from config, reporting
+// settings it doesn't understand
+func configureWeather(config weatherConfig) []configProblem {
+	var problems []configProblem
+	w := defaultWeatherSettings()
+
+	switch p := strings.ToLower(strings.TrimSpace(config.Provider)); p {
+	case "":
+	case "wttr", "none":
+		w.provider = p
+	default:
+		problems = append(problems, newConfigProblem(toml.Key{"weather", "provider"},
+			"unknown weather provider %q, expected \"wttr\" or \"none\"", config.Provider))
+	}
+	if loc := strings.TrimSpace(config.Location); loc != "" {
+		w.location = loc
+	}
+	switch strings.ToLower(strings.TrimSpace(config.Units)) {
+	case "", "metric":
+	case "imperial":
+		w.imperial = true
+	default:
+		problems = append(problems, newConfigProblem(toml.Key{"weather", "units"},
+			"unknown units %q, expected \"metric\" or \"imperial\"", config.Units))
+	}
+
+	duration := func(value, name string, target *time.Duration) {
+		if value == "" {
+			return
+		}
+		d, ok := parseConfigDuration(value)
+		if !ok || d <= 0 {
+			problems = append(problems, newConfigProblem(toml.Key{"weather", name},
+				"invalid %s %q, expected a duration like \"30m\"", name, value))
+			return
+		}
+		*target = d
+	}
+	duration(config.Refresh, "refresh", &w.refresh)
+	duration(config.CacheTTL, "cacheTTL", &w.cacheTTL)
+
+	if u := strings.TrimSpace(config.URL); u != "" {
+		if parsed, err := url.Parse(u); err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			problems = append(problems, newConfigProblem(toml.Key{"weather", "url"}, "invalid weather url %q", config.URL))
+		} else {
+			w.baseURL = strings.TrimRight(u, "/")
+		}
+	}
+
+	weather = w
+	return problems
+}
+
+// weatherReport is the current conditions, in both unit systems so a change
+// of units doesn't need a new fetch
+type weatherReport struct {
+	Location    string    `json:"location"`
+	Fetched     time.Time `json:"fetched"`
+	Description string    `json:"description"`
+	TempC       string    `json:"tempC"`
+	TempF       string    `json:"tempF"`
+	WindKmph    string    `json:"windKmph"`
+	WindMph     string    `json:"windMph"`
+	VisKm       string    `json:"visKm"`
+	VisMiles    string    `json:"visMiles"`
+	Humidity    string    `json:"humidity"`
+
+	Days []forecastDay `json:"days"` // daily forecast, starting today
+}
+
+// weatherProvider fetches the current weather for a location
+type weatherProvider interface {
+	fetch(location string) (weatherReport, error)
+}
+
+// newWeatherProvider returns the provider for settings, or nil when weather
+// is turned off
+func newWeatherProvider(w weatherSettings) weatherProvider {
+	if w.provider == "none" {
+		return nil
+	}
+	return wttrProvider{baseURL: w.baseURL, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+// wttrProvider reads wttr.in's JSON format
+type wttrProvider struct {
+	baseURL string
+	client  *http.Client
+}
+
 type WeatherCondition struct {
 	TempC       string `json:"temp_C"`
+	TempF       string `json:"temp_F"`
 	WeatherDesc []struct {
 		Value string `json:"value"`
 	} `json:"weatherDesc"`
-	WindspeedKmph string `json:"windspeedKmph"`
-	Visibility    string `json:"visibility"`
-	Humidity      string `json:"humidity"`
+	WindspeedKmph   string `json:"windspeedKmph"`
+	WindspeedMiles  string `json:"windspeedMiles"`
+	Visibility      string `json:"visibility"`
+	VisibilityMiles string `json:"visibilityMiles"`
+	Humidity        string `json:"humidity"`
+}
+
+// WeatherDay is one day of wttr.in's forecast
+type WeatherDay struct {
+	Date     string `json:"date"`
+	MaxtempC string `json:"maxtempC"`
+	MintempC string `json:"mintempC"`
+	MaxtempF string `json:"maxtempF"`
+	MintempF string `json:"mintempF"`
+	Hourly   []struct {
+		Time        string `json:"time"` // "0", "300", ... "2100"
+		WeatherDesc []struct {
+			Value string `json:"value"`
+		} `json:"weatherDesc"`
+		ChanceOfRain string `json:"chanceofrain"`
+	} `json:"hourly"`
 }
 
 type WeatherResponse struct {
 	CurrentCondition []WeatherCondition `json:"current_condition"`
+	Weather          []WeatherDay       `json:"weather"`
 }
 
-func getWeather() string {
-	resp, err := http.Get("http://wttr.in/Waterloo+Ontario?format=j2")
+func (p wttrProvider) fetch(location string) (weatherReport, error) {
+	// wttr.in writes spaces in locations as "+"
+	path := strings.ReplaceAll(url.PathEscape(location), "%20", "+")
+	// j1 rather than j2 for the hourly forecast, where day descriptions and
+	// chance of rain come from
+	resp, err := p.client.Get(p.baseURL + "/" + path + "?format=j1")
 	if err != nil {
-		return "Weather unavailable"
+		return weatherReport{}, err
 	}
 	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return weatherReport{}, fmt.Errorf("weather: %s", resp.Status)
+	}
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return "Weather unavailable"
+		return weatherReport{}, err
 	}
 	var wr WeatherResponse
-	err = json.Unmarshal(body, &wr)
-	if err != nil {
-		return "Weather unavailable"
+	if err := json.Unmarshal(body, &wr); err != nil {
+		return weatherReport{}, err
 	}
 	if len(wr.CurrentCondition) == 0 {
-		return "Weather unavailable"
+		return weatherReport{}, errors.New("weather: no current conditions")
 	}
 	wc := wr.CurrentCondition[0]
-	desc := wc.WeatherDesc[0].Value
-	temp := wc.TempC
-	wind := wc.WindspeedKmph
-	vis := wc.Visibility
-	hum := wc.Humidity
-	return fmt.Sprintf("%s  %s°C 煮%s km/h  %s km  %s%%", desc, temp, wind, vis, hum)
+	var desc string
+	if len(wc.WeatherDesc) > 0 {
+		desc = strings.TrimSpace(wc.WeatherDesc[0].Value)
+	}
+	return weatherReport{
+		Location:    location,
+		Description: desc,
+		TempC:       wc.TempC,
+		TempF:       wc.TempF,
+		WindKmph:    wc.WindspeedKmph,
+		WindMph:     wc.WindspeedMiles,
+		VisKm:       wc.Visibility,
+		VisMiles:    wc.VisibilityMiles,
+		Humidity:    wc.Humidity,
+		Days:        forecastDays(wr.Weather),
+	}, nil
+}
+
+func weatherCachePath() string {
+	cacheHome := os.Getenv("XDG_CACHE_HOME")
+	if cacheHome == "" {
+		cacheHome = filepath.Join(os.ExpandEnv("$HOME"), ".cache")
+	}
+	return filepath.Join(cacheHome, "reminders-dashboard", "weather.json")
+}
+
+// readWeatherCache returns the last report saved for location
+func readWeatherCache(location string) (weatherReport, bool) {
+	data, err := os.ReadFile(weatherCachePath())
+	if err != nil {
+		return weatherReport{}, false
+	}
+	var report weatherReport
+	if err := json.Unmarshal(data, &report); err != nil || report.Location != location {
+		return weatherReport{}, false
+	}
+	return report, true
 }
 
-func getUsername() string {
-	cmd := exec.Command("whoami")
-	out, err := cmd.Output()
+func writeWeatherCache(report weatherReport) error {
+	data, err := json.Marshal(report)
 	if err != nil {
-		return "User"
+		return err
+	}
+	return writeFileAtomic(weatherCachePath(), data)
+}
+
+// weatherMsg is the result of a weather fetch. stale means the fetch failed
+// and report is the last cached one. cacheErr is a failure to save a fetched
+// report, which is still shown. gen is the fetch schedule it belongs to.
+type weatherMsg struct {
+	report   weatherReport
+	stale    bool
+	err      error
+	cacheErr error
+	gen      int
+}
+
+// loadWeather returns the cached report while it is younger than the cache
+// TTL, fetching a new one otherwise. When fetching fails the cached report is
+// used whatever its age.
+func loadWeather(p weatherProvider, w weatherSettings, now time.Time) weatherMsg {
+	cached, haveCache := readWeatherCache(w.location)
+	if haveCache && now.Sub(cached.Fetched) < w.cacheTTL {
+		return weatherMsg{report: cached}
+	}
+	report, err := p.fetch(w.location)
+	if err != nil {
+		if haveCache {
+			return weatherMsg{report: cached, stale: true, err: err}
+		}
+		return weatherMsg{err: err}
+	}
+	report.Fetched = now
+	return weatherMsg{report: report, cacheErr: writeWeatherCache(report)}
+}
+
+// fetchWeatherCmd loads the weather with the active settings. Returns nil
+// when weather is turned off.
+func fetchWeatherCmd(gen int) tea.Cmd {
+	w := weather
+	p := newWeatherProvider(w)
+	if p == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		msg := loadWeather(p, w, currentTime())
+		msg.gen = gen
+		return msg
+	}
+}
+
+// weatherRefreshMsg asks for the next scheduled weather fetch. Messages from
+// an older schedule (before the weather settings were reloaded) are dropped.
+type weatherRefreshMsg struct {
+	gen int
+}
+
+// scheduleWeatherCmd fetches the weather again after the refresh interval
+func scheduleWeatherCmd(gen int) tea.Cmd {
+	if weather.provider == "none" {
+		return nil
+	}
+	return tea.Tick(weather.refresh, func(time.Time) tea.Msg {
+		return weatherRefreshMsg{gen: gen}
+	})
+}
+
+// formatWeather renders a report in the configured units, marking reports
+// that couldn't be refreshed with their age
+func formatWeather(msg weatherMsg, now time.Time) string {
+	if msg.report.Fetched.IsZero() {
+		return "Weather unavailable"
+	}
+	r := msg.report
+	var text string
+	if weather.imperial {
+		text = fmt.Sprintf("%s  %s°F 煮%s mph  %s mi  %s%%", r.Description, r.TempF, r.WindMph, r.VisMiles, r.Humidity)
+	} else {
+		text = fmt.Sprintf("%s  %s°C 煮%s km/h  %s km  %s%%", r.Description, r.TempC, r.WindKmph, r.VisKm, r.Humidity)
+	}
+	if msg.stale {
+		text += " (" + shortAge(now.Sub(r.Fetched)) + " ago)"
+	}
+	return text
+}
+
+// shortAge formats a duration compactly, e.g. "5m", "2h" or "3d"
+func shortAge(d time.Duration) string {
+	switch {
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", max(int(d.Minutes()), 0))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	default:
+		return fmt.Sprintf("%dd", int(d.Hours()/24))
 	}
-	return strings.TrimSpace(string(out))
 }
 
 func titleCase(s string) string {
@@ -67,8 +352,8 @@ func titleCase(s string) string {
 	return strings.ToUpper(s[:1]) + strings.ToLower(s[1:])
 }
 
-func getGreeting() string {
-	hour := time.Now().Hour()
+func getGreeting(now time.Time) string {
+	hour := now.Hour()
 	if hour < 12 {
 		return "Good morning"
 	} else if hour < 18 {
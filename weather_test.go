@@ -0,0 +1,178 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const wttrResponse = `{
+	"current_condition": [{
+		"temp_C": "12", "temp_F": "54",
+		"weatherDesc": [{"value": "Light rain "}],
+		"windspeedKmph": "15", "windspeedMiles": "9",
+		"visibility": "10", "visibilityMiles": "6",
+		"humidity": "81"
+	}],
+	"weather": [{
+		"date": "2026-10-18",
+		"maxtempC": "14", "mintempC": "6", "maxtempF": "57", "mintempF": "43",
+		"hourly": [
+			{"time": "0", "weatherDesc": [{"value": "Cloudy"}], "chanceofrain": "20"},
+			{"time": "1200", "weatherDesc": [{"value": "Light rain"}], "chanceofrain": "80"}
+		]
+	}]
+}`
+
+const wttrNoDescription = `{
+	"current_condition": [{"temp_C": "12", "temp_F": "54", "humidity": "81"}],
+	"weather": []
+}`
+
+// wttrStandIn serves body with status for every request, counting requests
+// and recording the last path asked for
+func wttrStandIn(t *testing.T, status int, body string) (*httptest.Server, *atomic.Int32, *string) {
+	t.Helper()
+	var requests atomic.Int32
+	var path string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		path = r.URL.RawPath
+		if path == "" {
+			path = r.URL.Path
+		}
+		if r.URL.Query().Get("format") != "j1" {
+			t.Errorf("format = %q, want j1", r.URL.Query().Get("format"))
+		}
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &requests, &path
+}
+
+func TestWttrProviderFetch(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		body     string
+		wantErr  bool
+		wantDesc string
+		wantDays int
+	}{
+		{"good response", http.StatusOK, wttrResponse, false, "Light rain", 1},
+		{"missing weatherDesc", http.StatusOK, wttrNoDescription, false, "", 0},
+		{"non-200 status", http.StatusServiceUnavailable, "Unknown location", true, "", 0},
+		{"no current conditions", http.StatusOK, `{"current_condition": []}`, true, "", 0},
+		{"not JSON", http.StatusOK, "<html>", true, "", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, _, path := wttrStandIn(t, tt.status, tt.body)
+			p := wttrProvider{baseURL: srv.URL, client: srv.Client()}
+
+			report, err := p.fetch("Waterloo Ontario")
+			if *path != "/Waterloo+Ontario" {
+				t.Errorf("path = %q, want /Waterloo+Ontario", *path)
+			}
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("fetch = %+v, want an error", report)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("fetch: %v", err)
+			}
+			if report.Location != "Waterloo Ontario" || report.Description != tt.wantDesc || report.TempC != "12" || report.TempF != "54" {
+				t.Errorf("fetch = %+v", report)
+			}
+			if len(report.Days) != tt.wantDays {
+				t.Fatalf("got %d forecast days, want %d", len(report.Days), tt.wantDays)
+			}
+			if tt.wantDays > 0 {
+				want := forecastDay{Date: "2026-10-18", Description: "Light rain", MaxC: "14", MinC: "6", MaxF: "57", MinF: "43", ChanceOfRain: 80}
+				if report.Days[0] != want {
+					t.Errorf("forecast = %+v, want %+v", report.Days[0], want)
+				}
+			}
+		})
+	}
+}
+
+func TestLoadWeather(t *testing.T) {
+	now := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	cached := weatherReport{Location: "Waterloo Ontario", Fetched: now.Add(-2 * time.Hour), Description: "Sunny", TempC: "8"}
+
+	tests := []struct {
+		name         string
+		cache        *weatherReport // saved two hours before now
+		ttl          time.Duration
+		status       int
+		wantRequests int32
+		wantDesc     string
+		wantStale    bool
+		wantErr      bool
+	}{
+		{"fresh cache within TTL", &cached, 3 * time.Hour, http.StatusOK, 0, "Sunny", false, false},
+		{"expired cache is refetched", &cached, time.Hour, http.StatusOK, 1, "Light rain", false, false},
+		{"no cache", nil, time.Hour, http.StatusOK, 1, "Light rain", false, false},
+		{"stale cache when the fetch fails", &cached, time.Hour, http.StatusInternalServerError, 1, "Sunny", true, true},
+		{"no cache and the fetch fails", nil, time.Hour, http.StatusInternalServerError, 1, "", false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("XDG_CACHE_HOME", t.TempDir())
+			srv, requests, _ := wttrStandIn(t, tt.status, wttrResponse)
+			p := wttrProvider{baseURL: srv.URL, client: srv.Client()}
+
+			w := defaultWeatherSettings()
+			w.cacheTTL = tt.ttl
+			if tt.cache != nil {
+				if err := writeWeatherCache(*tt.cache); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			msg := loadWeather(p, w, now)
+			if got := requests.Load(); got != tt.wantRequests {
+				t.Errorf("%d requests, want %d", got, tt.wantRequests)
+			}
+			if msg.report.Description != tt.wantDesc || msg.stale != tt.wantStale || (msg.err != nil) != tt.wantErr {
+				t.Errorf("loadWeather = %+v", msg)
+			}
+			if tt.wantRequests > 0 && !tt.wantErr {
+				// A successful fetch is stamped and replaces the cache
+				if !msg.report.Fetched.Equal(now) {
+					t.Errorf("fetched = %v, want %v", msg.report.Fetched, now)
+				}
+				if saved, ok := readWeatherCache(w.location); !ok || saved.Description != "Light rain" {
+					t.Errorf("cache = %+v, %v", saved, ok)
+				}
+			}
+		})
+	}
+}
+
+func TestLoadWeatherCacheError(t *testing.T) {
+	// A file where the cache dir should be, so the report can't be saved
+	blocked := filepath.Join(t.TempDir(), "cache")
+	if err := os.WriteFile(blocked, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("XDG_CACHE_HOME", blocked)
+	srv, _, _ := wttrStandIn(t, http.StatusOK, wttrResponse)
+	p := wttrProvider{baseURL: srv.URL, client: srv.Client()}
+
+	msg := loadWeather(p, defaultWeatherSettings(), time.Now())
+	if msg.err != nil || msg.report.Description != "Light rain" {
+		t.Errorf("loadWeather = %+v, want the fetched report", msg)
+	}
+	if msg.cacheErr == nil {
+		t.Error("cacheErr = nil, want the failed cache write")
+	}
+}